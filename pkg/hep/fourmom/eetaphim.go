@@ -0,0 +1,85 @@
+package fourmom
+
+import (
+	"math"
+)
+
+// EEtaPhiM is a four-momentum stored as energy, pseudo-rapidity,
+// azimuthal angle and mass
+type EEtaPhiM struct {
+	mom [4]float64
+}
+
+// Create a new EEtaPhiM four-momentum. The azimuthal angle is wrapped to
+// (-pi, pi].
+func NewEEtaPhiM(e, eta, phi, m float64) EEtaPhiM {
+	return EEtaPhiM{mom: [4]float64{e, eta, azimuth(phi), m}}
+}
+
+func (p EEtaPhiM) E() float64 {
+	return p.mom[0]
+}
+
+func (p EEtaPhiM) Eta() float64 {
+	return p.mom[1]
+}
+
+func (p EEtaPhiM) Phi() float64 {
+	return p.mom[2]
+}
+
+func (p EEtaPhiM) M() float64 {
+	return p.mom[3]
+}
+
+func (p EEtaPhiM) M2() float64 {
+	m := p.mom[3]
+	return m * math.Abs(m)
+}
+
+// Returns the magnitude of the 3-momentum, sqrt(E**2 - M2), or 0 for
+// unphysical E < M
+func (p EEtaPhiM) P() float64 {
+	e := p.mom[0]
+	m := p.mom[3]
+	if m < 0 {
+		return math.Hypot(e, m)
+	}
+	p2 := (e - m) * (e + m)
+	if p2 <= 0 {
+		return 0
+	}
+	return math.Sqrt(p2)
+}
+
+func (p EEtaPhiM) Pt() float64 {
+	return p.P() / math.Cosh(p.mom[1])
+}
+
+func (p EEtaPhiM) Px() float64 {
+	return p.Pt() * math.Cos(p.mom[2])
+}
+
+func (p EEtaPhiM) Py() float64 {
+	return p.Pt() * math.Sin(p.mom[2])
+}
+
+// Returns the z-component of the momentum, P*tanh(eta), which stays finite
+// for very forward particles
+func (p EEtaPhiM) Pz() float64 {
+	return p.P() * math.Tanh(p.mom[1])
+}
+
+func (p EEtaPhiM) Rapidity() float64 {
+	return rapidity(p.Pz(), energy(p.Pt(), p.mom[3]))
+}
+
+func (p EEtaPhiM) CosTheta() float64 {
+	return math.Tanh(p.mom[1])
+}
+
+func (p EEtaPhiM) Et() float64 {
+	return p.mom[0] / math.Cosh(p.mom[1])
+}
+
+// EOF
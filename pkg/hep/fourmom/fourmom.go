@@ -1,11 +1,98 @@
+// Package fourmom provides four-momentum types in various coordinate
+// systems.
+//
+// Each concrete type stores its native coordinates and computes the others
+// on demand.
+// Masses follow the usual convention for space-like four-vectors: if
+// M2() is negative, M() returns -sqrt(-M2()).
 package fourmom
 
+import (
+	"math"
+)
+
+// I4Mom is the interface implemented by all four-momenta
 type I4Mom interface {
-	Px() float64
+	Px() float64 // x-component of the momentum
+	Py() float64 // y-component of the momentum
+	Pz() float64 // z-component of the momentum
+	E() float64  // energy
+
+	M() float64  // invariant mass
+	M2() float64 // squared invariant mass
+
+	Pt() float64       // transverse momentum
+	Eta() float64      // pseudo-rapidity
+	Phi() float64      // azimuthal angle, in (-pi, pi]
+	Rapidity() float64 // rapidity
+	P() float64        // magnitude of the 3-momentum
+	CosTheta() float64 // cosine of the polar angle
+	Et() float64       // transverse energy
+}
+
+// make sure the concrete types implement I4Mom
+var (
+	_ I4Mom = PxPyPzE{}
+	_ I4Mom = EEtaPhiM{}
+	_ I4Mom = PtEtaPhiM{}
+	_ I4Mom = IPtCotThPhiM{}
+)
+
+// mass returns the signed square root of the squared mass 'm2'
+func mass(m2 float64) float64 {
+	if m2 < 0 {
+		return -math.Sqrt(-m2)
+	}
+	return math.Sqrt(m2)
+}
+
+// energy returns the energy sqrt(p**2 + M2) of a four-momentum with a
+// 3-momentum of magnitude 'p' and a signed mass 'm', M2 being m*|m|.
+// 0 is returned for an unphysical space-like four-momentum with p < |m|.
+func energy(p, m float64) float64 {
+	if m >= 0 {
+		return math.Hypot(p, m)
+	}
+	e2 := (p - m) * (p + m)
+	if e2 <= 0 {
+		return 0
+	}
+	return math.Sqrt(e2)
+}
+
+// azimuth returns the angle 'phi' wrapped to (-pi, pi]
+func azimuth(phi float64) float64 {
+	phi = math.Remainder(phi, 2*math.Pi)
+	if phi <= -math.Pi {
+		phi += 2 * math.Pi
+	}
+	return phi
+}
+
+// rapidity returns the rapidity given the z-component of the momentum and
+// the transverse mass mt = sqrt(E**2 - pz**2).
+// asinh(pz/mt) does not suffer from the cancellation of E-pz for forward
+// particles.
+func rapidity(pz, mt float64) float64 {
+	switch {
+	case pz == 0:
+		return 0
+	case mt <= 0:
+		return math.Copysign(math.Inf(1), pz)
+	}
+	return math.Asinh(pz / mt)
 }
 
-type PxPyPzE struct {
-	mom [4]float64
+// pseudoRapidity returns the pseudo-rapidity given the transverse and
+// longitudinal components of the momentum
+func pseudoRapidity(pt, pz float64) float64 {
+	switch {
+	case pt != 0:
+		return math.Asinh(pz / pt)
+	case pz == 0:
+		return 0
+	}
+	return math.Copysign(math.Inf(1), pz)
 }
 
 // EOF
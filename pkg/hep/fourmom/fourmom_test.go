@@ -0,0 +1,77 @@
+package fourmom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSpaceLike(t *testing.T) {
+	for _, c := range []struct {
+		ref  PxPyPzE
+		m, y float64
+	}{
+		{NewPxPyPzE(1, 0, 0, 0.5), -math.Sqrt(0.75), 0},
+		// E < |pz|: the transverse mass is imaginary
+		{NewPxPyPzE(1, 0, 2, 1.5), -math.Sqrt(2.75), math.Inf(+1)},
+	} {
+		ref := c.ref
+		m := ref.M()
+		if math.Abs(m-c.m) > 1e-12 {
+			t.Fatalf("%v: M() = %v, want %v", ref, m, c.m)
+		}
+		if y := ref.Rapidity(); !(y == c.y || math.Abs(y-c.y) <= 1e-12) {
+			t.Errorf("%v: Rapidity() = %v, want %v", ref, y, c.y)
+		}
+		for _, p := range []I4Mom{
+			NewPtEtaPhiM(ref.Pt(), ref.Eta(), ref.Phi(), m),
+			NewIPtCotThPhiM(1/ref.Pt(), ref.Pz()/ref.Pt(), ref.Phi(), m),
+			NewEEtaPhiM(ref.E(), ref.Eta(), ref.Phi(), m),
+		} {
+			for _, c := range []struct {
+				name      string
+				got, want float64
+			}{
+				{"E", p.E(), ref.E()},
+				{"P", p.P(), ref.P()},
+				{"M2", p.M2(), ref.M2()},
+				{"Px", p.Px(), ref.Px()},
+				{"Pz", p.Pz(), ref.Pz()},
+				{"Rapidity", p.Rapidity(), ref.Rapidity()},
+			} {
+				if !(c.got == c.want || math.Abs(c.got-c.want) <= 1e-12) {
+					t.Errorf("%T.%s() = %v, want %v", p, c.name, c.got, c.want)
+				}
+			}
+		}
+	}
+}
+
+func TestPhiRange(t *testing.T) {
+	for _, c := range []struct {
+		phi, want float64
+	}{
+		{0, 0},
+		{1, 1},
+		{math.Pi, math.Pi},
+		{-math.Pi, math.Pi},
+		{3 * math.Pi / 2, -math.Pi / 2},
+		{-3 * math.Pi / 2, math.Pi / 2},
+		{7, 7 - 2*math.Pi},
+	} {
+		for _, p := range []I4Mom{
+			NewPtEtaPhiM(1, 0, c.phi, 0),
+			NewIPtCotThPhiM(1, 0, c.phi, 0),
+			NewEEtaPhiM(1, 0, c.phi, 0),
+			NewPxPyPzE(math.Cos(c.phi), math.Sin(c.phi), 0, 1),
+		} {
+			got := p.Phi()
+			if got <= -math.Pi || got > math.Pi || math.Abs(got-c.want) > 1e-12 {
+				t.Errorf("%T.Phi() for phi=%v: got %v, want %v", p, c.phi, got, c.want)
+			}
+		}
+	}
+	p := NewPxPyPzE(-1, math.Copysign(0, -1), 0, 1)
+	if got := p.Phi(); got != math.Pi {
+		t.Errorf("Phi() of %v: got %v, want pi", p, got)
+	}
+}
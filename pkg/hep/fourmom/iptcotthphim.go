@@ -0,0 +1,85 @@
+package fourmom
+
+import (
+	"math"
+)
+
+// IPtCotThPhiM is a four-momentum stored as inverse transverse momentum,
+// cotangent of the polar angle, azimuthal angle and mass, as commonly used
+// for tracks.
+// The inverse transverse momentum may carry the sign of the charge.
+type IPtCotThPhiM struct {
+	mom [4]float64
+}
+
+// Create a new IPtCotThPhiM four-momentum. The azimuthal angle is wrapped to
+// (-pi, pi].
+func NewIPtCotThPhiM(ipt, cotth, phi, m float64) IPtCotThPhiM {
+	return IPtCotThPhiM{mom: [4]float64{ipt, cotth, azimuth(phi), m}}
+}
+
+// Returns the (signed) inverse transverse momentum
+func (p IPtCotThPhiM) IPt() float64 {
+	return p.mom[0]
+}
+
+// Returns the cotangent of the polar angle
+func (p IPtCotThPhiM) CotTh() float64 {
+	return p.mom[1]
+}
+
+func (p IPtCotThPhiM) Phi() float64 {
+	return p.mom[2]
+}
+
+func (p IPtCotThPhiM) M() float64 {
+	return p.mom[3]
+}
+
+func (p IPtCotThPhiM) M2() float64 {
+	m := p.mom[3]
+	return m * math.Abs(m)
+}
+
+func (p IPtCotThPhiM) Pt() float64 {
+	return 1 / math.Abs(p.mom[0])
+}
+
+func (p IPtCotThPhiM) Px() float64 {
+	return p.Pt() * math.Cos(p.mom[2])
+}
+
+func (p IPtCotThPhiM) Py() float64 {
+	return p.Pt() * math.Sin(p.mom[2])
+}
+
+func (p IPtCotThPhiM) Pz() float64 {
+	return p.Pt() * p.mom[1]
+}
+
+func (p IPtCotThPhiM) P() float64 {
+	return p.Pt() * math.Hypot(1, p.mom[1])
+}
+
+func (p IPtCotThPhiM) E() float64 {
+	return energy(p.P(), p.mom[3])
+}
+
+// Returns the pseudo-rapidity, asinh(cot(theta))
+func (p IPtCotThPhiM) Eta() float64 {
+	return math.Asinh(p.mom[1])
+}
+
+func (p IPtCotThPhiM) Rapidity() float64 {
+	return rapidity(p.Pz(), energy(p.Pt(), p.mom[3]))
+}
+
+func (p IPtCotThPhiM) CosTheta() float64 {
+	return p.mom[1] / math.Hypot(1, p.mom[1])
+}
+
+func (p IPtCotThPhiM) Et() float64 {
+	return p.E() / math.Hypot(1, p.mom[1])
+}
+
+// EOF
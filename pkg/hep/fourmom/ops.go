@@ -0,0 +1,136 @@
+package fourmom
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrSuperluminal is returned when boosting with a velocity |beta| >= 1,
+	// or with an undefined one
+	ErrSuperluminal = errors.New("fourmom: boost velocity must be less than 1")
+
+	// ErrNullAxis is returned when rotating about a null vector
+	ErrNullAxis = errors.New("fourmom: rotation axis must be non-null")
+)
+
+// Returns the sum of the four-momenta
+func Add(ps ...I4Mom) PxPyPzE {
+	var o PxPyPzE
+	for _, p := range ps {
+		o.mom[0] += p.Px()
+		o.mom[1] += p.Py()
+		o.mom[2] += p.Pz()
+		o.mom[3] += p.E()
+	}
+	return o
+}
+
+// Returns the difference of the four-momenta: a - b
+func Sub(a, b I4Mom) PxPyPzE {
+	return NewPxPyPzE(a.Px()-b.Px(), a.Py()-b.Py(), a.Pz()-b.Pz(), a.E()-b.E())
+}
+
+// Returns the four-momentum scaled by 'f'
+func Scale(p I4Mom, f float64) PxPyPzE {
+	return NewPxPyPzE(f*p.Px(), f*p.Py(), f*p.Pz(), f*p.E())
+}
+
+// Returns the invariant mass of the system of the four-momenta
+func InvMass(ps ...I4Mom) float64 {
+	return Add(ps...).M()
+}
+
+// Returns the minkowski product of the four-momenta:
+//  E_a*E_b - p_a.p_b
+func Dot(a, b I4Mom) float64 {
+	return a.E()*b.E() - a.Px()*b.Px() - a.Py()*b.Py() - a.Pz()*b.Pz()
+}
+
+// Returns the difference in azimuthal angle, wrapped to [-pi, pi)
+func DeltaPhi(a, b I4Mom) float64 {
+	dphi := math.Remainder(a.Phi()-b.Phi(), 2*math.Pi)
+	if dphi >= math.Pi {
+		dphi -= 2 * math.Pi
+	}
+	return dphi
+}
+
+// Returns the difference in pseudo-rapidity
+func DeltaEta(a, b I4Mom) float64 {
+	return a.Eta() - b.Eta()
+}
+
+// Returns the distance in the (eta, phi) plane:
+//  sqrt(deta**2 + dphi**2)
+func DeltaR(a, b I4Mom) float64 {
+	return math.Hypot(DeltaEta(a, b), DeltaPhi(a, b))
+}
+
+// Returns the velocity (p/E) of the four-momentum.
+// Boosting with the opposite of this vector brings the four-momentum to
+// rest. The components are infinite or NaN if E is 0: Boost rejects such
+// a velocity with ErrSuperluminal.
+func BoostVector(p I4Mom) [3]float64 {
+	e := p.E()
+	return [3]float64{p.Px() / e, p.Py() / e, p.Pz() / e}
+}
+
+// Returns the four-momentum boosted by the velocity 'beta'.
+// To transform 'p' into the rest frame of 'q':
+//  Boost(p, Scale3(BoostVector(q), -1))
+func Boost(p I4Mom, beta [3]float64) (PxPyPzE, error) {
+	b2 := beta[0]*beta[0] + beta[1]*beta[1] + beta[2]*beta[2]
+	if !(b2 < 1) {
+		return PxPyPzE{}, ErrSuperluminal
+	}
+	px, py, pz, e := p.Px(), p.Py(), p.Pz(), p.E()
+	if b2 == 0 {
+		return NewPxPyPzE(px, py, pz, e), nil
+	}
+	gamma := 1 / math.Sqrt(1-b2)
+	bp := beta[0]*px + beta[1]*py + beta[2]*pz
+	// (gamma-1)/b2, written as gamma**2/(gamma+1) to avoid the
+	// cancellation for small velocities
+	gamma2 := gamma * gamma / (gamma + 1)
+	f := gamma2*bp + gamma*e
+	return NewPxPyPzE(
+		px+f*beta[0],
+		py+f*beta[1],
+		pz+f*beta[2],
+		gamma*(e+bp),
+	), nil
+}
+
+// Returns the 3-vector scaled by 'f'
+func Scale3(v [3]float64, f float64) [3]float64 {
+	return [3]float64{f * v[0], f * v[1], f * v[2]}
+}
+
+// Returns the four-momentum rotated by 'angle' (in radians, counter
+// clockwise) about 'axis'
+func Rotate(p I4Mom, axis [3]float64, angle float64) (PxPyPzE, error) {
+	n := math.Sqrt(axis[0]*axis[0] + axis[1]*axis[1] + axis[2]*axis[2])
+	if n == 0 {
+		return PxPyPzE{}, ErrNullAxis
+	}
+	k := Scale3(axis, 1/n)
+	v := [3]float64{p.Px(), p.Py(), p.Pz()}
+	sin, cos := math.Sincos(angle)
+	// Rodrigues' rotation formula:
+	//  v' = v cos + (k x v) sin + k (k.v) (1-cos)
+	kv := k[0]*v[0] + k[1]*v[1] + k[2]*v[2]
+	kxv := [3]float64{
+		k[1]*v[2] - k[2]*v[1],
+		k[2]*v[0] - k[0]*v[2],
+		k[0]*v[1] - k[1]*v[0],
+	}
+	var o PxPyPzE
+	for i := range v {
+		o.mom[i] = v[i]*cos + kxv[i]*sin + k[i]*kv*(1-cos)
+	}
+	o.mom[3] = p.E()
+	return o, nil
+}
+
+// EOF
@@ -0,0 +1,170 @@
+package fourmom
+
+import (
+	"math"
+	"testing"
+)
+
+func checkP4(t *testing.T, name string, got, want I4Mom, tol float64) {
+	t.Helper()
+	for _, c := range []struct {
+		comp string
+		g, w float64
+	}{
+		{"Px", got.Px(), want.Px()},
+		{"Py", got.Py(), want.Py()},
+		{"Pz", got.Pz(), want.Pz()},
+		{"E", got.E(), want.E()},
+	} {
+		if math.Abs(c.g-c.w) > tol {
+			t.Errorf("%s: %s = %v, want %v", name, c.comp, c.g, c.w)
+		}
+	}
+}
+
+func TestAddSub(t *testing.T) {
+	a := NewPxPyPzE(1, 2, 3, 10)
+	b := NewPtEtaPhiM(2, 0.5, -1, 1)
+	c := NewEEtaPhiM(7, -1, 2, 0.5)
+
+	want := NewPxPyPzE(
+		a.Px()+b.Px()+c.Px(),
+		a.Py()+b.Py()+c.Py(),
+		a.Pz()+b.Pz()+c.Pz(),
+		a.E()+b.E()+c.E(),
+	)
+	checkP4(t, "Add", Add(a, b, c), want, 1e-12)
+	checkP4(t, "Add()", Add(), NewPxPyPzE(0, 0, 0, 0), 0)
+	checkP4(t, "Sub", Sub(Add(a, b, c), c), Add(a, b), 1e-12)
+	checkP4(t, "Sub(b, b)", Sub(b, b), NewPxPyPzE(0, 0, 0, 0), 0)
+}
+
+func TestInvMass(t *testing.T) {
+	// back-to-back massless particles of energy 5
+	a := NewPtEtaPhiM(5, 0, 0.3, 0)
+	b := NewPtEtaPhiM(5, 0, 0.3-math.Pi, 0)
+	if m := InvMass(a, b); math.Abs(m-10) > 1e-12 {
+		t.Errorf("InvMass = %v, want 10", m)
+	}
+	p := NewPxPyPzE(1, 2, 3, 5)
+	if m := InvMass(p); math.Abs(m-math.Sqrt(11)) > 1e-12 {
+		t.Errorf("InvMass = %v, want %v", m, math.Sqrt(11))
+	}
+}
+
+func TestDeltaPhiDeltaR(t *testing.T) {
+	const eps = 1e-3
+	a := NewPtEtaPhiM(1, 1, math.Pi-eps, 0)
+	b := NewPtEtaPhiM(1, -1, -math.Pi+eps, 0)
+	// the difference wraps around +-pi
+	if dphi := DeltaPhi(b, a); math.Abs(dphi-2*eps) > 1e-12 {
+		t.Errorf("DeltaPhi(b, a) = %v, want %v", dphi, 2*eps)
+	}
+	if dphi := DeltaPhi(a, b); math.Abs(dphi+2*eps) > 1e-12 {
+		t.Errorf("DeltaPhi(a, b) = %v, want %v", dphi, -2*eps)
+	}
+	if dr, want := DeltaR(a, b), math.Hypot(2, 2*eps); math.Abs(dr-want) > 1e-12 {
+		t.Errorf("DeltaR = %v, want %v", dr, want)
+	}
+
+	for _, c := range []struct {
+		phia, phib, want float64
+	}{
+		{0.5, -0.5, 1},
+		{3, -3, 6 - 2*math.Pi},
+		{-3, 3, 2*math.Pi - 6},
+		{math.Pi / 2, -math.Pi / 2, -math.Pi},
+	} {
+		a := NewPtEtaPhiM(1, 0, c.phia, 0)
+		b := NewPtEtaPhiM(1, 0, c.phib, 0)
+		if dphi := DeltaPhi(a, b); math.Abs(dphi-c.want) > 1e-12 {
+			t.Errorf("DeltaPhi(%v, %v) = %v, want %v", c.phia, c.phib, dphi, c.want)
+		}
+	}
+}
+
+func TestBoost(t *testing.T) {
+	p := NewPxPyPzE(1, 2, 3, 5)
+	rest, err := Boost(p, Scale3(BoostVector(p), -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := math.Sqrt(11); math.Abs(rest.E()-m) > 1e-12 || rest.P() > 1e-12 {
+		t.Errorf("rest frame: E = %v and |p| = %v, want %v and 0", rest.E(), rest.P(), m)
+	}
+
+	// boosting back and forth, and along z
+	beta := [3]float64{0.3, -0.2, 0.6}
+	q, err := Boost(p, beta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(q.M2()-p.M2()) > 1e-12 {
+		t.Errorf("boosted M2 = %v, want %v", q.M2(), p.M2())
+	}
+	back, err := Boost(q, Scale3(beta, -1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkP4(t, "boost back", back, p, 1e-12)
+
+	gamma := 1 / math.Sqrt(1-0.36)
+	q, err = Boost(p, [3]float64{0, 0, 0.6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NewPxPyPzE(1, 2, gamma*(3+0.6*5), gamma*(5+0.6*3))
+	checkP4(t, "boost along z", q, want, 1e-12)
+
+	q, err = Boost(p, [3]float64{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkP4(t, "null boost", q, p, 0)
+
+	for _, beta := range [][3]float64{
+		{1, 0, 0},
+		{0.6, 0.8, 0},
+		BoostVector(NewPxPyPzE(0, 0, 0, 0)),
+		BoostVector(NewPxPyPzE(1, 0, 0, 0)),
+	} {
+		if _, err := Boost(p, beta); err != ErrSuperluminal {
+			t.Errorf("Boost(%v): got error %v, want %v", beta, err, ErrSuperluminal)
+		}
+	}
+}
+
+func TestRotate(t *testing.T) {
+	p := NewPxPyPzE(1, 0, 0, 2)
+	q, err := Rotate(p, [3]float64{0, 0, 2}, math.Pi/2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkP4(t, "rotation about z", q, NewPxPyPzE(0, 1, 0, 2), 1e-12)
+
+	p = NewPxPyPzE(1, 2, 3, 5)
+	axis := [3]float64{1, -1, 0.5}
+	q, err = Rotate(p, axis, 0.7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(q.P()-p.P()) > 1e-12 || q.E() != p.E() {
+		t.Errorf("rotated |p| = %v and E = %v, want %v and %v", q.P(), q.E(), p.P(), p.E())
+	}
+	// the component along the axis is preserved
+	along := func(v I4Mom) float64 {
+		return v.Px()*axis[0] + v.Py()*axis[1] + v.Pz()*axis[2]
+	}
+	if math.Abs(along(q)-along(p)) > 1e-12 {
+		t.Errorf("rotated component along the axis = %v, want %v", along(q), along(p))
+	}
+	back, err := Rotate(q, axis, -0.7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkP4(t, "rotate back", back, p, 1e-12)
+
+	if _, err := Rotate(p, [3]float64{}, 1); err != ErrNullAxis {
+		t.Errorf("null axis: got error %v, want %v", err, ErrNullAxis)
+	}
+}
@@ -0,0 +1,74 @@
+package fourmom
+
+import (
+	"math"
+)
+
+// PtEtaPhiM is a four-momentum stored as transverse momentum,
+// pseudo-rapidity, azimuthal angle and mass
+type PtEtaPhiM struct {
+	mom [4]float64
+}
+
+// Create a new PtEtaPhiM four-momentum. The azimuthal angle is wrapped to
+// (-pi, pi].
+func NewPtEtaPhiM(pt, eta, phi, m float64) PtEtaPhiM {
+	return PtEtaPhiM{mom: [4]float64{pt, eta, azimuth(phi), m}}
+}
+
+func (p PtEtaPhiM) Pt() float64 {
+	return p.mom[0]
+}
+
+func (p PtEtaPhiM) Eta() float64 {
+	return p.mom[1]
+}
+
+func (p PtEtaPhiM) Phi() float64 {
+	return p.mom[2]
+}
+
+func (p PtEtaPhiM) M() float64 {
+	return p.mom[3]
+}
+
+func (p PtEtaPhiM) M2() float64 {
+	m := p.mom[3]
+	return m * math.Abs(m)
+}
+
+func (p PtEtaPhiM) Px() float64 {
+	return p.mom[0] * math.Cos(p.mom[2])
+}
+
+func (p PtEtaPhiM) Py() float64 {
+	return p.mom[0] * math.Sin(p.mom[2])
+}
+
+func (p PtEtaPhiM) Pz() float64 {
+	return p.mom[0] * math.Sinh(p.mom[1])
+}
+
+func (p PtEtaPhiM) P() float64 {
+	return p.mom[0] * math.Cosh(p.mom[1])
+}
+
+func (p PtEtaPhiM) E() float64 {
+	return energy(p.P(), p.mom[3])
+}
+
+// Returns the rapidity, asinh(pt*sinh(eta)/mt), which is exact for
+// massless particles (y == eta)
+func (p PtEtaPhiM) Rapidity() float64 {
+	return rapidity(p.Pz(), energy(p.mom[0], p.mom[3]))
+}
+
+func (p PtEtaPhiM) CosTheta() float64 {
+	return math.Tanh(p.mom[1])
+}
+
+func (p PtEtaPhiM) Et() float64 {
+	return p.E() / math.Cosh(p.mom[1])
+}
+
+// EOF
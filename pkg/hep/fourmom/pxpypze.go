@@ -0,0 +1,88 @@
+package fourmom
+
+import (
+	"math"
+)
+
+// PxPyPzE is a four-momentum stored as cartesian coordinates
+type PxPyPzE struct {
+	mom [4]float64
+}
+
+// Create a new PxPyPzE four-momentum
+func NewPxPyPzE(px, py, pz, e float64) PxPyPzE {
+	return PxPyPzE{mom: [4]float64{px, py, pz, e}}
+}
+
+func (p PxPyPzE) Px() float64 {
+	return p.mom[0]
+}
+
+func (p PxPyPzE) Py() float64 {
+	return p.mom[1]
+}
+
+func (p PxPyPzE) Pz() float64 {
+	return p.mom[2]
+}
+
+func (p PxPyPzE) E() float64 {
+	return p.mom[3]
+}
+
+// Returns the squared invariant mass, computed as (E-P)(E+P) to limit the
+// cancellation for light, energetic particles
+func (p PxPyPzE) M2() float64 {
+	e := p.E()
+	mom := p.P()
+	return (e - mom) * (e + mom)
+}
+
+func (p PxPyPzE) M() float64 {
+	return mass(p.M2())
+}
+
+func (p PxPyPzE) Pt() float64 {
+	return math.Hypot(p.mom[0], p.mom[1])
+}
+
+func (p PxPyPzE) P() float64 {
+	return math.Hypot(p.Pt(), p.mom[2])
+}
+
+func (p PxPyPzE) Eta() float64 {
+	return pseudoRapidity(p.Pt(), p.mom[2])
+}
+
+func (p PxPyPzE) Phi() float64 {
+	if p.mom[0] == 0 && p.mom[1] == 0 {
+		return 0
+	}
+	return azimuth(math.Atan2(p.mom[1], p.mom[0]))
+}
+
+func (p PxPyPzE) Rapidity() float64 {
+	e := p.E()
+	pz := p.mom[2]
+	mt2 := (e - pz) * (e + pz)
+	return rapidity(pz, mass(mt2))
+}
+
+func (p PxPyPzE) CosTheta() float64 {
+	mom := p.P()
+	if mom == 0 {
+		return 1
+	}
+	return p.mom[2] / mom
+}
+
+// Returns the transverse energy, E*sin(theta)
+func (p PxPyPzE) Et() float64 {
+	mom := p.P()
+	if mom == 0 {
+		return 0
+	}
+	return p.E() * p.Pt() / mom
+}
+
+// EOF
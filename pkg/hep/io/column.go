@@ -0,0 +1,405 @@
+package io
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// number of entries per chunk of a column
+const _CHUNK_SIZE = 4096
+
+// the column types, by name
+var column_types = map[string]reflect.Type{
+	"bool":      reflect.TypeOf(false),
+	"int32":     reflect.TypeOf(int32(0)),
+	"int64":     reflect.TypeOf(int64(0)),
+	"float32":   reflect.TypeOf(float32(0)),
+	"float64":   reflect.TypeOf(float64(0)),
+	"string":    reflect.TypeOf(""),
+	"[]float64": reflect.TypeOf([]float64(nil)),
+}
+
+// description of a column of a ColumnTuple
+type column_desc struct {
+	Name string
+	Type string
+}
+
+// column_entry returns the name of the archive entry holding a column
+func column_entry(tuple, column string) string {
+	return tuple + ".col." + column
+}
+
+// schema_of returns the columns described by the exported fields of the
+// struct type 'rt', and the index of the corresponding fields.
+// A field can be renamed with a `hepio:"name"` tag, or skipped with
+// `hepio:"-"`.
+func schema_of(rt reflect.Type) ([]column_desc, []int, error) {
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt.Kind() != reflect.Struct {
+		return nil, nil, errors.New("hep/io: column tuple schema must be a struct (got " + rt.String() + ")")
+	}
+	var cols []column_desc
+	var fields []int
+	seen := make(map[string]bool)
+	for i := 0; i < rt.NumField(); i++ {
+		ft := rt.Field(i)
+		if ft.PkgPath != "" {
+			// unexported
+			continue
+		}
+		name := ft.Name
+		if tag := ft.Tag.Get("hepio"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			name = tag
+		}
+		if strings.ContainsAny(name, "/\\") {
+			return nil, nil, errors.New("hep/io: invalid column name [" + name + "]")
+		}
+		if seen[name] {
+			return nil, nil, errors.New("hep/io: duplicate column [" + name + "]")
+		}
+		seen[name] = true
+		typ := ft.Type.String()
+		if column_types[typ] != ft.Type {
+			return nil, nil, errors.New("hep/io: unsupported type " + typ + " for column [" + name + "]")
+		}
+		cols = append(cols, column_desc{Name: name, Type: typ})
+		fields = append(fields, i)
+	}
+	if len(cols) == 0 {
+		return nil, nil, errors.New("hep/io: column tuple schema " + rt.String() + " has no columns")
+	}
+	return cols, fields, nil
+}
+
+// a column being written: the values are buffered and written as
+// gob-encoded chunks into a gzip stream
+type column_writer struct {
+	field int
+	f     *os.File
+	compr *gzip.Writer
+	enc   *gob.Encoder
+	buf   reflect.Value
+}
+
+func (c *column_writer) flush() error {
+	if c.buf.Len() == 0 {
+		return nil
+	}
+	err := c.enc.EncodeValue(c.buf)
+	if err != nil {
+		return err
+	}
+	c.buf = c.buf.Slice(0, 0)
+	return nil
+}
+
+// a column being read, one chunk at a time
+type column_reader struct {
+	field int
+	compr *gzip.Reader
+	dec   *gob.Decoder
+	buf   reflect.Value // pointer to the current chunk
+	pos   int
+}
+
+func (c *column_reader) next() (reflect.Value, error) {
+	if c.pos >= c.buf.Elem().Len() {
+		// each chunk is decoded into a new slice: gob would reuse the
+		// backing arrays of the previous one, which the values handed out
+		// so far may share
+		buf := reflect.New(c.buf.Type().Elem())
+		err := c.dec.DecodeValue(buf)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		c.buf = buf
+		c.pos = 0
+		if buf.Elem().Len() == 0 {
+			return reflect.Value{}, io.ErrUnexpectedEOF
+		}
+	}
+	v := c.buf.Elem().Index(c.pos)
+	c.pos++
+	return v, nil
+}
+
+// ColumnTuple is a HEP n-tuple stored column-wise: each column is held in
+// its own compressed stream, so that columns can be read independently.
+// The columns are declared from the exported fields of a struct.
+type ColumnTuple struct {
+	rtype    reflect.Type
+	cols     []column_desc
+	w        []*column_writer
+	r        map[string]*column_reader
+	nentries int64
+	// the error of a failed chunk flush: the columns may then be out of
+	// step, and no more entries can be written
+	err error
+}
+
+// Create a new column-wise Tuple with the schema of the struct 'schema'
+// (a struct value or a pointer to a struct).
+// Supported field types are bool, int32, int64, float32, float64, string
+// and []float64.
+func (f *File) CreateColumnTuple(name string, schema interface{}) (*ColumnTuple, error) {
+	if f.ra != nil {
+		return nil, errors.New("hep/io: cannot create tuple [" + name + "] in read mode")
+	}
+	rt := reflect.TypeOf(schema)
+	if rt == nil {
+		return nil, errors.New("hep/io: nil column tuple schema")
+	}
+	cols, fields, err := schema_of(rt)
+	if err != nil {
+		return nil, err
+	}
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	t := &ColumnTuple{
+		rtype: rt,
+		cols:  cols,
+		w:     make([]*column_writer, 0, len(cols)),
+	}
+	for i, col := range cols {
+		ff, err := os.Create(filepath.Join(f.dir, column_entry(name, col.Name)))
+		if err != nil {
+			t.Close()
+			return nil, err
+		}
+		w, err := gzip.NewWriterLevel(ff, gzip.DefaultCompression)
+		if err != nil {
+			ff.Close()
+			t.Close()
+			return nil, err
+		}
+		t.w = append(t.w, &column_writer{
+			field: fields[i],
+			f:     ff,
+			compr: w,
+			enc:   gob.NewEncoder(w),
+			buf:   reflect.MakeSlice(reflect.SliceOf(column_types[col.Type]), 0, _CHUNK_SIZE),
+		})
+	}
+	if f.ctuples == nil {
+		f.ctuples = make(map[string]*ColumnTuple)
+	}
+	f.ctuples[name] = t
+	return t, nil
+}
+
+// Open the column-wise Tuple 'name' for reading.
+// Only the requested columns are read, or all of them if none is given.
+func (f *File) OpenColumnTuple(name string, columns ...string) (*ColumnTuple, error) {
+	if f.ra == nil {
+		return nil, errors.New("hep/io: cannot open tuple [" + name + "] in write mode")
+	}
+	mdata, err := f.fetch_metadata_for(name)
+	if err != nil {
+		return nil, err
+	}
+	if mdata.Columns == nil {
+		return nil, errors.New("hep/io: tuple [" + name + "] is not a column tuple")
+	}
+	if len(columns) == 0 {
+		for _, col := range mdata.Columns {
+			columns = append(columns, col.Name)
+		}
+	}
+	t := &ColumnTuple{
+		r:        make(map[string]*column_reader, len(columns)),
+		nentries: mdata.Nentries,
+	}
+	for _, cname := range columns {
+		var desc *column_desc
+		for i := range mdata.Columns {
+			if mdata.Columns[i].Name == cname {
+				desc = &mdata.Columns[i]
+				break
+			}
+		}
+		if desc == nil {
+			t.Close()
+			return nil, errors.New("hep/io: no column [" + cname + "] in tuple [" + name + "]")
+		}
+		ct, ok := column_types[desc.Type]
+		if !ok {
+			t.Close()
+			return nil, errors.New("hep/io: unsupported type " + desc.Type + " for column [" + cname + "]")
+		}
+		sr, err := f.entry(column_entry(name, cname))
+		if err != nil {
+			t.Close()
+			return nil, err
+		}
+		r, err := gzip.NewReader(sr)
+		if err != nil {
+			t.Close()
+			return nil, err
+		}
+		buf := reflect.New(reflect.SliceOf(ct))
+		t.r[cname] = &column_reader{
+			compr: r,
+			dec:   gob.NewDecoder(r),
+			buf:   buf,
+		}
+		t.cols = append(t.cols, *desc)
+	}
+	if f.ctuples == nil {
+		f.ctuples = make(map[string]*ColumnTuple)
+	}
+	f.ctuples[name] = t
+	return t, nil
+}
+
+// Returns the names of the columns of the tuple (the opened ones, in read
+// mode)
+func (t *ColumnTuple) Columns() []string {
+	names := make([]string, len(t.cols))
+	for i, col := range t.cols {
+		names[i] = col.Name
+	}
+	return names
+}
+
+// Write the fields of 'v', a value of (or a pointer to) the schema struct,
+// as a new entry
+func (t *ColumnTuple) Write(v interface{}) error {
+	if t.w == nil {
+		return errors.New("hep/io: tuple not opened for writing")
+	}
+	if t.err != nil {
+		return t.err
+	}
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Type() != t.rtype {
+		return errors.New("hep/io: cannot write a " + rv.Type().String() +
+			" into a column tuple of " + t.rtype.String())
+	}
+	for _, c := range t.w {
+		fv := rv.Field(c.field)
+		if fv.Kind() == reflect.Slice && !fv.IsNil() {
+			// the chunk is only encoded when full: take a copy, the
+			// caller may reuse its slice
+			cp := reflect.MakeSlice(fv.Type(), fv.Len(), fv.Len())
+			reflect.Copy(cp, fv)
+			fv = cp
+		}
+		c.buf = reflect.Append(c.buf, fv)
+	}
+	t.nentries += 1
+	if t.w[0].buf.Len() < _CHUNK_SIZE {
+		return nil
+	}
+	// all the columns hold the same number of entries: their chunks are
+	// flushed together
+	for _, c := range t.w {
+		err := c.flush()
+		if err != nil {
+			t.err = errors.New("hep/io: problem writing a chunk of column tuple: " + err.Error())
+			return t.err
+		}
+	}
+	return nil
+}
+
+// Read the next entry into 'v', a pointer to a struct.
+// Only the fields matching the opened columns (by name, or by their hepio
+// tag) are set.
+// io.EOF is returned after the last entry.
+func (t *ColumnTuple) Read(v interface{}) error {
+	if t.r == nil {
+		return errors.New("hep/io: tuple not opened for reading")
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("hep/io: column tuple Read needs a pointer to a struct")
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+	if rt != t.rtype {
+		// bind the columns to the fields of the new type
+		for _, c := range t.r {
+			c.field = -1
+		}
+		for i := 0; i < rt.NumField(); i++ {
+			ft := rt.Field(i)
+			name := ft.Name
+			if tag := ft.Tag.Get("hepio"); tag != "" {
+				name = tag
+			}
+			c, ok := t.r[name]
+			if !ok || ft.PkgPath != "" {
+				continue
+			}
+			if ft.Type != c.buf.Type().Elem().Elem() {
+				return errors.New("hep/io: cannot read column [" + name + "] into a field of type " + ft.Type.String())
+			}
+			c.field = i
+		}
+		t.rtype = rt
+	}
+	for _, c := range t.r {
+		val, err := c.next()
+		if err != nil {
+			return err
+		}
+		if c.field >= 0 {
+			rv.Field(c.field).Set(val)
+		}
+	}
+	return nil
+}
+
+func (t *ColumnTuple) Entries() int64 {
+	return t.nentries
+}
+
+func (t *ColumnTuple) Close() error {
+	err := t.err
+	for _, c := range t.w {
+		if c.compr == nil {
+			continue
+		}
+		var e error
+		if t.err == nil {
+			// the chunks of a failed tuple are out of step: they are
+			// not completed
+			e = c.flush()
+		}
+		if e == nil {
+			e = c.compr.Close()
+		}
+		if e == nil {
+			e = c.f.Sync()
+		}
+		if e2 := c.f.Close(); e == nil {
+			e = e2
+		}
+		c.compr = nil
+		if e != nil && err == nil {
+			err = e
+		}
+	}
+	for _, c := range t.r {
+		e := c.compr.Close()
+		if e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// EOF
@@ -0,0 +1,164 @@
+package io
+
+import (
+	"encoding/gob"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+type col_event struct {
+	E     float64
+	N     int32
+	Ok    bool
+	Pts   []float64 `hepio:"pts"`
+	Label string
+	skip  int
+}
+
+// a subset of the columns of col_event, in another order
+type col_event_sub struct {
+	Pts []float64 `hepio:"pts"`
+	E   float64
+}
+
+func make_col_event(i int) col_event {
+	pts := make([]float64, i%4)
+	for j := range pts {
+		pts[j] = float64(i + j)
+	}
+	return col_event{
+		E:     float64(i) * 0.5,
+		N:     int32(-i),
+		Ok:    i%3 == 0,
+		Pts:   pts,
+		Label: string(rune('a' + i%26)),
+	}
+}
+
+func TestColumnTupleRoundTrip(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "data.hio")
+	f, err := Create(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := f.CreateColumnTuple("evts", col_event{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// several full chunks and a partial one
+	const n = 3*_CHUNK_SIZE + 17
+	for i := 0; i < n; i++ {
+		evt := make_col_event(i)
+		err = ct.Write(&evt)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	ct, err = r.OpenColumnTuple("evts", "pts", "E")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct.Entries() != n {
+		t.Fatalf("got %d entries, want %d", ct.Entries(), n)
+	}
+	// the values read are kept, and compared once all the chunks have
+	// been read: they must not share the buffers of the later chunks
+	gots := make([]col_event_sub, n)
+	for i := range gots {
+		err = ct.Read(&gots[i])
+		if err != nil {
+			t.Fatalf("entry %d: %v", i, err)
+		}
+	}
+	for i, got := range gots {
+		evt := make_col_event(i)
+		want := col_event_sub{Pts: evt.Pts, E: evt.E}
+		if len(want.Pts) == 0 {
+			// gob decodes empty slices as nil ones
+			want.Pts = nil
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("entry %d: got %+v, want %+v", i, got, want)
+		}
+	}
+	var got col_event_sub
+	err = ct.Read(&got)
+	if err != io.EOF {
+		t.Fatalf("after the last entry: got error %v, want io.EOF", err)
+	}
+}
+
+type failing_writer struct{}
+
+var err_failing_writer = errors.New("failing writer")
+
+func (failing_writer) Write([]byte) (int, error) {
+	return 0, err_failing_writer
+}
+
+func TestColumnTupleFlushFailure(t *testing.T) {
+	fname := filepath.Join(t.TempDir(), "data.hio")
+	f, err := Create(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := f.CreateColumnTuple("evts", col_event{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the chunks of the second column cannot be written
+	ct.w[1].enc = gob.NewEncoder(failing_writer{})
+	for i := 0; i < _CHUNK_SIZE-1; i++ {
+		evt := make_col_event(i)
+		err = ct.Write(&evt)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	evt := make_col_event(0)
+	err = ct.Write(&evt)
+	if err == nil {
+		t.Fatalf("expected an error when the chunk is flushed")
+	}
+	// no entry may be added to some columns only
+	nentries := ct.Entries()
+	nbuf := make([]int, len(ct.w))
+	for i, c := range ct.w {
+		nbuf[i] = c.buf.Len()
+	}
+	err2 := ct.Write(&evt)
+	if err2 != err {
+		t.Errorf("write after a failed flush: got error %v, want %v", err2, err)
+	}
+	if ct.Entries() != nentries {
+		t.Errorf("write after a failed flush: %d entries, want %d", ct.Entries(), nentries)
+	}
+	for i, c := range ct.w {
+		if c.buf.Len() != nbuf[i] {
+			t.Errorf("write after a failed flush: column %d holds %d entries, want %d", i, c.buf.Len(), nbuf[i])
+		}
+	}
+	// the first column was flushed, not the following ones: the file
+	// must not be written with columns out of step
+	err = f.Close()
+	if err == nil {
+		t.Errorf("Close of a file with a failed tuple: expected an error")
+	}
+	if _, err := os.Stat(fname); !os.IsNotExist(err) {
+		t.Errorf("file %s written despite a failed tuple: %v", fname, err)
+	}
+}
@@ -13,8 +13,7 @@ import (
 )
 
 const (
-	_HEPIO_ROOT = "__go-hep-io__"
-	_HEADER     = "__header__"
+	_HEADER = "__header__"
 )
 
 type adict map[string]interface{}
@@ -30,31 +29,42 @@ type readerz struct {
 }
 
 type File struct {
-	dir    string
+	fname  string // output file name (write mode)
+	dir    string // staging directory (write mode)
 	hdr    *os.File
 	tuples map[string]*Tuple
+	// column-wise tuples
+	ctuples map[string]*ColumnTuple
+
+	// read mode: the archive and the index of its entries
+	ra      io.ReaderAt
+	raw     io.Closer
+	entries map[string]tar_entry
+	mdata   map[string]metadata
+}
+
+// location of a regular file inside the tar archive
+type tar_entry struct {
+	offset int64
+	size   int64
 }
 
+// Create creates the hep/io file fname.
+// The tuples are staged in a private temporary directory until Close
+// writes the final archive to fname.
 func Create(fname string) (*File, error) {
-	dir_root := filepath.Join(_HEPIO_ROOT, fname)
-	err := os.RemoveAll(dir_root)
-	if err != nil {
-		return nil, err
-	}
-	dirname, err := filepath.Abs(dir_root)
-	if err != nil {
-		return nil, err
-	}
-	err = os.MkdirAll(dirname, 0776)
+	dirname, err := os.MkdirTemp("", "go-hep-io-")
 	if err != nil {
 		return nil, err
 	}
 	// create header file
 	f, err := os.Create(filepath.Join(dirname, _HEADER))
 	if err != nil {
+		os.RemoveAll(dirname)
 		return nil, err
 	}
 	hepfile := &File{
+		fname:  fname,
 		dir:    dirname,
 		hdr:    f,
 		tuples: make(map[string]*Tuple),
@@ -62,35 +72,35 @@ func Create(fname string) (*File, error) {
 	return hepfile, nil
 }
 
+// Open opens the hep/io file fname for reading.
+// Only the tar headers are read: the tuples are streamed from the archive
+// when they are opened.
 func Open(fname string) (*File, error) {
-	dir_root := filepath.Join(_HEPIO_ROOT, fname)
-	err := os.RemoveAll(dir_root)
-	if err != nil {
-		return nil, err
-	}
-	dirname, err := filepath.Abs(dir_root)
+	raw_f, err := os.Open(fname)
 	if err != nil {
 		return nil, err
 	}
-	err = os.MkdirAll(dirname, 0776)
+	fi, err := raw_f.Stat()
 	if err != nil {
+		raw_f.Close()
 		return nil, err
 	}
-
-	// open hepfile
-	raw_f, err := os.Open(fname)
+	f, err := OpenReaderAt(raw_f, fi.Size())
 	if err != nil {
+		raw_f.Close()
 		return nil, err
 	}
-	tr := tar.NewReader(raw_f)
+	f.raw = raw_f
+	return f, nil
+}
 
-	// create local header file
-	f, err := os.Create(filepath.Join(dirname, _HEADER))
-	if err != nil {
-		return nil, err
-	}
-	// untar everything
-	// FIXME: make this lazy...
+// OpenReaderAt opens the hep/io file of size 'size' held by r for reading.
+// r must stay valid until the returned File is closed.
+func OpenReaderAt(r io.ReaderAt, size int64) (*File, error) {
+	sr := io.NewSectionReader(r, 0, size)
+	// the tar reader seeks over the content of the entries
+	tr := tar.NewReader(sr)
+	entries := make(map[string]tar_entry)
 	for {
 		hdr, err := tr.Next()
 		if err == io.EOF {
@@ -100,37 +110,50 @@ func Open(fname string) (*File, error) {
 		if err != nil {
 			return nil, err
 		}
-		if hdr.Name == _HEADER {
-			_, err = io.Copy(f, tr)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			ff, err := os.Create(filepath.Join(dirname,
-				filepath.Base(hdr.Name)))
-			if err != nil {
-				return nil, err
-			}
-			_, err = io.Copy(ff, tr)
-			if err != nil {
-				return nil, err
-			}
-			err = ff.Close()
-			if err != nil {
-				return nil, err
-			}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
 		}
+		// tar.Reader reads the headers block by block and does no
+		// read-ahead: after Next, the current position is the start of
+		// the entry content. This is not documented by archive/tar, so the
+		// offset is checked to lie on a block boundary.
+		offset, err := sr.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		if offset%512 != 0 {
+			return nil, errors.New("hep/io: misaligned entry [" + hdr.Name + "]")
+		}
+		if hdr.Size < 0 || offset+hdr.Size > size {
+			return nil, errors.New("hep/io: truncated entry [" + hdr.Name + "]")
+		}
+		entries[filepath.Base(hdr.Name)] = tar_entry{offset: offset, size: hdr.Size}
+	}
+	if _, ok := entries[_HEADER]; !ok {
+		return nil, errors.New("hep/io: no header in file")
 	}
 	hepfile := &File{
-		dir:    dirname,
-		hdr:    f,
-		tuples: make(map[string]*Tuple),
+		tuples:  make(map[string]*Tuple),
+		ra:      r,
+		entries: entries,
 	}
 	return hepfile, nil
 }
 
+// entry returns a reader over the content of the tar entry name
+func (f *File) entry(name string) (*io.SectionReader, error) {
+	e, ok := f.entries[name]
+	if !ok {
+		return nil, errors.New("hep/io: no such entry [" + name + "]")
+	}
+	return io.NewSectionReader(f.ra, e.offset, e.size), nil
+}
+
 // Create a new Tuple in folder name 
 func (f *File) CreateTuple(name string) (*Tuple, error) {
+	if f.ra != nil {
+		return nil, errors.New("hep/io: cannot create tuple [" + name + "] in read mode")
+	}
 	ff, err := os.Create(filepath.Join(f.dir, name))
 	if err != nil {
 		return nil, err
@@ -146,60 +169,80 @@ func (f *File) CreateTuple(name string) (*Tuple, error) {
 }
 
 func (f *File) OpenTuple(name string) (*Tuple, error) {
-	ff, err := os.Open(filepath.Join(f.dir, name))
+	if f.ra == nil {
+		return nil, errors.New("hep/io: cannot open tuple [" + name + "] in write mode")
+	}
+	// retrieve metadata for this n-tuple
+	metadata, err := f.fetch_metadata_for(name)
 	if err != nil {
 		return nil, err
 	}
-	//fi,err := ff.Stat()
-	//println("file:",ff.Name(),"size:",fi.Size)
-	r, err := gzip.NewReader(ff)
+	if metadata.Columns != nil {
+		return nil, errors.New("hep/io: tuple [" + name + "] is a column tuple")
+	}
+	sr, err := f.entry(name)
+	if err != nil {
+		return nil, err
+	}
+	r, err := gzip.NewReader(sr)
 	if err != nil {
 		return nil, err
 	}
 	dec := gob.NewDecoder(r)
 	rz := &readerz{dec: dec, compr: r}
-	// retrieve metadata for this n-tuple
-	metadata := f.fetch_metadata_for(name)
-	var nentries int64 = 0
-	nentries = metadata.Nentries
-	f.tuples[name] = &Tuple{f: ff, w: nil, r: rz, nentries: nentries}
+	f.tuples[name] = &Tuple{w: nil, r: rz, nentries: metadata.Nentries}
 	return f.tuples[name], nil
 }
 
-func (f *File) fetch_metadata_for(name string) metadata {
-	// save current position
-	cur, err := f.hdr.Seek(0, 1)
-	defer f.hdr.Seek(cur, 0)
-
-	_, err = f.hdr.Seek(0, 0)
-	if err != nil {
-		panic(err)
-	}
-	r, err := gzip.NewReader(f.hdr)
-	if err != nil {
-		panic(err)
-	}
-	dec := gob.NewDecoder(r)
-	// read hepfile metadata
-	file_mdata := make(map[string]interface{})
-	err = dec.Decode(&file_mdata)
-	if err != nil {
-		panic(err)
-	}
-	//println("file metadata:",file_mdata["version"].(uint32))
-	mdata := make(map[string]metadata)
-	err = dec.Decode(&mdata)
-	if err != nil {
-		panic(err)
+func (f *File) fetch_metadata_for(name string) (metadata, error) {
+	if f.mdata == nil {
+		sr, err := f.entry(_HEADER)
+		if err != nil {
+			return metadata{}, err
+		}
+		r, err := gzip.NewReader(sr)
+		if err != nil {
+			return metadata{}, err
+		}
+		defer r.Close()
+		dec := gob.NewDecoder(r)
+		// read hepfile metadata
+		file_mdata := make(map[string]interface{})
+		err = dec.Decode(&file_mdata)
+		if err != nil {
+			return metadata{}, err
+		}
+		mdata := make(map[string]metadata)
+		err = dec.Decode(&mdata)
+		if err != nil {
+			return metadata{}, err
+		}
+		f.mdata = mdata
 	}
-	v, ok := mdata[name]
+	v, ok := f.mdata[name]
 	if !ok {
-		panic("no metadata for tuple [" + name + "]")
+		return metadata{}, errors.New("hep/io: no metadata for tuple [" + name + "]")
 	}
-	return v
+	return v, nil
 }
 
-func (f *File) Close() error {
+func (f *File) Close() (err error) {
+	if f.ra != nil {
+		return f.close_reader()
+	}
+	// the staging directory is removed even if the archive could not be
+	// written
+	defer func() {
+		e := f.hdr.Close()
+		if err == nil {
+			err = e
+		}
+		e = os.RemoveAll(f.dir)
+		if err == nil {
+			err = e
+		}
+	}()
+
 	metadata := make(map[string]metadata)
 	for n, t := range f.tuples {
 		//println("-- closing:",t.f.Name())
@@ -209,6 +252,13 @@ func (f *File) Close() error {
 		}
 		metadata[n] = make_metadata_from(t)
 	}
+	for n, t := range f.ctuples {
+		err := t.Close()
+		if err != nil {
+			return errors.New("problem closing [" + n + "]: " + err.Error())
+		}
+		metadata[n] = make_column_metadata_from(n, t)
+	}
 
 	w, err := gzip.NewWriterLevel(f.hdr, gzip.DefaultCompression)
 	if err != nil {
@@ -216,7 +266,8 @@ func (f *File) Close() error {
 	}
 	enc := gob.NewEncoder(w)
 	mdata := map[string]interface{}{
-		"version": uint32(0x00000001),
+		// version 2: column-wise tuples
+		"version": uint32(0x00000002),
 	}
 	err = enc.Encode(&mdata)
 	if err != nil {
@@ -231,74 +282,79 @@ func (f *File) Close() error {
 		return err
 	}
 
-	// now create the final tar-file
-	outname := filepath.Base(f.dir)
-	out, err := os.Create(outname)
+	return f.write_archive()
+}
+
+// write_archive writes the header and the tuples into the tar archive
+// f.fname.
+// The archive is first written to a temporary file in the same directory,
+// then renamed, so that readers never see a partially written file.
+func (f *File) write_archive() error {
+	out, err := os.CreateTemp(filepath.Dir(f.fname), "."+filepath.Base(f.fname)+".tmp-")
 	if err != nil {
 		return err
 	}
-	tw := tar.NewWriter(out)
-	// write top directory
-	s := strings.Split(outname, ".")
-	root_name := strings.Join(s[:len(s)-1], ".")
-	fi, err := out.Stat()
+	tmpname := out.Name()
+	err = f.write_tar(out)
+	if err == nil {
+		err = out.Chmod(0644)
+	}
+	if err == nil {
+		err = out.Sync()
+	}
+	if e := out.Close(); e != nil && err == nil {
+		err = e
+	}
+	if err == nil {
+		err = os.Rename(tmpname, f.fname)
+	}
 	if err != nil {
-		println(err)
-		return err
+		os.Remove(tmpname)
 	}
+	return err
+}
+
+func (f *File) write_tar(out io.Writer) error {
+	tw := tar.NewWriter(out)
+	// write top directory
+	outname := filepath.Base(f.fname)
+	root_name := strings.TrimSuffix(outname, filepath.Ext(outname))
 	hdr := &tar.Header{
-		Name: root_name,
-		Mode: int64(492),
-		//Uid:      fi.Uid,
-		//Gid:      fi.Gid,
+		Name:     root_name,
+		Mode:     int64(0754),
 		Size:     0,
 		Typeflag: tar.TypeDir,
-		//Mtime:    fi.Mtime_ns / 1e9, // ns to s
-		//Atime:    fi.Atime_ns / 1e9,
-		//Ctime:    fi.Ctime_ns / 1e9,
 	}
 
-	err = tw.WriteHeader(hdr)
+	err := tw.WriteHeader(hdr)
 	if err != nil {
 		return err
 	}
 	tarfile := func(fd *os.File) error {
-		fd.Sync()
 		tuple, err := os.Open(fd.Name())
 		if err != nil {
-			println(err.Error())
 			return err
 		}
+		defer tuple.Close()
 		stats, err := tuple.Stat()
 		if err != nil {
-			println(err)
 			return err
 		}
 		hdr := &tar.Header{
-			Name: filepath.Join(root_name, filepath.Base(fd.Name())),
-			Mode: int64(fi.Mode()),
-			//Uid:      stats.Uid,
-			//Gid:      stats.Gid,
+			Name:     filepath.Join(root_name, filepath.Base(fd.Name())),
+			Mode:     int64(0644),
 			Size:     stats.Size(),
 			Typeflag: tar.TypeReg,
 			ModTime:  stats.ModTime(),
-			//Atime:    stats.Atime_ns / 1e9, // to seconds
-			//Ctime:    stats.Ctime_ns / 1e9, // to seconds
 		}
-		//println("-->",hdr.Name,hdr.Size)
 		err = tw.WriteHeader(hdr)
 		if err != nil {
-			println(err)
 			return err
 		}
-		io.Copy(tw, tuple)
-		return nil
-	}
-	err = f.hdr.Sync()
-	if err != nil {
+		_, err = io.Copy(tw, tuple)
 		return err
 	}
-	_, err = f.hdr.Seek(0, 0)
+	err = f.hdr.Sync()
 	if err != nil {
 		return err
 	}
@@ -312,19 +368,38 @@ func (f *File) Close() error {
 			return err
 		}
 	}
-	err = tw.Close()
-	if err != nil {
-		return err
+	for _, t := range f.ctuples {
+		for _, c := range t.w {
+			err = tarfile(c.f)
+			if err != nil {
+				return err
+			}
+		}
 	}
+	return tw.Close()
+}
 
-	// clean-up
-	err = f.hdr.Close()
-	if err != nil {
-		return err
+// close_reader closes a file opened in read mode.
+// Nothing is written back.
+func (f *File) close_reader() error {
+	var err error
+	for n, t := range f.tuples {
+		e := t.Close()
+		if e != nil && err == nil {
+			err = errors.New("problem closing [" + n + "]: " + e.Error())
+		}
 	}
-	err = os.RemoveAll(f.dir)
-	if err != nil {
-		return err
+	for n, t := range f.ctuples {
+		e := t.Close()
+		if e != nil && err == nil {
+			err = errors.New("problem closing [" + n + "]: " + e.Error())
+		}
+	}
+	if f.raw != nil {
+		e := f.raw.Close()
+		if e != nil && err == nil {
+			err = e
+		}
 	}
 	return err
 }
@@ -333,6 +408,8 @@ func (f *File) Close() error {
 type metadata struct {
 	Name     string
 	Nentries int64
+	// the columns of a column-wise tuple (nil for row-wise tuples)
+	Columns []column_desc
 }
 
 func make_metadata_from(t *Tuple) metadata {
@@ -340,15 +417,22 @@ func make_metadata_from(t *Tuple) metadata {
 	return m
 }
 
+func make_column_metadata_from(name string, t *ColumnTuple) metadata {
+	return metadata{Name: name, Nentries: t.nentries, Columns: t.cols}
+}
+
 // a HEP n-tuple
 type Tuple struct {
-	f        *os.File
+	f        *os.File // nil in read mode
 	w        *writerz
 	r        *readerz
 	nentries int64
 }
 
 func (t *Tuple) Write(v interface{}) error {
+	if t.w == nil {
+		return errors.New("hep/io: tuple not opened for writing")
+	}
 	err := t.w.enc.Encode(v)
 	if err == nil {
 		t.nentries += 1
@@ -371,6 +455,9 @@ func (t *Tuple) Close() error {
 			return err
 		}
 	}
+	if t.f == nil {
+		return nil
+	}
 	err = t.f.Sync()
 	if err != nil {
 		return err
@@ -383,6 +470,9 @@ func (t *Tuple) Entries() int64 {
 }
 
 func (t *Tuple) Read(v interface{}) error {
+	if t.r == nil {
+		return errors.New("hep/io: tuple not opened for reading")
+	}
 	err := t.r.dec.Decode(v)
 	return err
 }
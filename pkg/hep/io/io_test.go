@@ -0,0 +1,332 @@
+package io
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCloseRemovesStagingDir(t *testing.T) {
+	dir := t.TempDir()
+	fname := filepath.Join(dir, "out", "data.hio")
+	err := os.Mkdir(filepath.Dir(fname), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Create(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tu, err := f.CreateTuple("evts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tu.Write(struct{ I int }{42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// make the archive impossible to write
+	err = os.RemoveAll(filepath.Dir(fname))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.Close()
+	if err == nil {
+		t.Fatalf("Close: expected an error writing into a removed directory")
+	}
+	if _, err := os.Stat(f.dir); !os.IsNotExist(err) {
+		t.Errorf("staging directory %s not removed: %v", f.dir, err)
+	}
+}
+
+type row_event struct {
+	I int
+	X float64
+	S string
+}
+
+func make_row_event(i int) row_event {
+	return row_event{I: i, X: float64(i) / 3, S: string(rune('a' + i%26))}
+}
+
+// write_row_file writes a file holding the tuple "evts" of n row_events
+func write_row_file(t *testing.T, fname string, n int) {
+	t.Helper()
+	f, err := Create(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tu, err := f.CreateTuple("evts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		err = tu.Write(make_row_event(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// read_row_file reads back the tuple "evts" of n row_events
+func read_row_file(f *File, n int) error {
+	tu, err := f.OpenTuple("evts")
+	if err != nil {
+		return err
+	}
+	if tu.Entries() != int64(n) {
+		return fmt.Errorf("got %d entries, want %d", tu.Entries(), n)
+	}
+	for i := 0; i < n; i++ {
+		var ev row_event
+		err = tu.Read(&ev)
+		if err != nil {
+			return fmt.Errorf("entry %d: %w", i, err)
+		}
+		if want := make_row_event(i); ev != want {
+			return fmt.Errorf("entry %d: got %+v, want %+v", i, ev, want)
+		}
+	}
+	var ev row_event
+	if err := tu.Read(&ev); err != io.EOF {
+		return fmt.Errorf("read past the last entry: got error %v, want io.EOF", err)
+	}
+	return nil
+}
+
+func TestRowTupleRoundTrip(t *testing.T) {
+	const n = 100
+	fname := filepath.Join(t.TempDir(), "rows.hio")
+	write_row_file(t, fname, n)
+
+	f, err := Open(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := read_row_file(f, n); err != nil {
+		t.Errorf("Open: %v", err)
+	}
+	err = f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err = OpenReaderAt(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := read_row_file(f, n); err != nil {
+		t.Errorf("OpenReaderAt: %v", err)
+	}
+	err = f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpenCorrupt(t *testing.T) {
+	const n = 100
+	fname := filepath.Join(t.TempDir(), "rows.hio")
+	write_row_file(t, fname, n)
+	data, err := os.ReadFile(fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an archive truncated in the middle of a block or of the content of an
+	// entry either fails to open, or fails to be read back
+	f, err := OpenReaderAt(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sizes := []int64{0, 100, 512, int64(len(data) / 2)}
+	for _, e := range f.entries {
+		sizes = append(sizes, e.offset+e.size/2)
+	}
+	for _, size := range sizes {
+		f, err := OpenReaderAt(bytes.NewReader(data), size)
+		if err != nil {
+			continue
+		}
+		if err := read_row_file(f, n); err == nil {
+			t.Errorf("archive truncated to %d bytes: no error", size)
+		}
+		f.Close()
+	}
+
+	// corrupt the header block of the first entry
+	bad := append([]byte(nil), data...)
+	copy(bad[512:], "garbage")
+	if _, err := OpenReaderAt(bytes.NewReader(bad), int64(len(bad))); err == nil {
+		t.Errorf("corrupt tar header: no error")
+	}
+
+	// an archive without __header__
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	content := []byte("content")
+	err = tw.WriteHeader(&tar.Header{
+		Name:     "rows/evts",
+		Mode:     0644,
+		Size:     int64(len(content)),
+		Typeflag: tar.TypeReg,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tw.Write(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = tw.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = OpenReaderAt(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err == nil || !strings.Contains(err.Error(), "no header") {
+		t.Errorf("missing header: got error %v", err)
+	}
+}
+
+func TestCreateSameBasename(t *testing.T) {
+	dir := t.TempDir()
+	fnames := []string{
+		filepath.Join(dir, "a", "data.hio"),
+		filepath.Join(dir, "b", "data.hio"),
+	}
+	// both files are staged at the same time
+	files := make([]*File, len(fnames))
+	for i, fname := range fnames {
+		err := os.Mkdir(filepath.Dir(fname), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[i], err = Create(fname)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if files[0].dir == files[1].dir {
+		t.Fatalf("files share the staging directory %s", files[0].dir)
+	}
+	for i, f := range files {
+		tu, err := f.CreateTuple("evts")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := 0; j < 10*(i+1); j++ {
+			err = tu.Write(make_row_event(j))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		err := files[i].Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i, fname := range fnames {
+		f, err := Open(fname)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := read_row_file(f, 10*(i+1)); err != nil {
+			t.Errorf("%s: %v", fname, err)
+		}
+		f.Close()
+	}
+}
+
+func TestCreateNoLeftovers(t *testing.T) {
+	// Close writes to the given path, relative to the working directory,
+	// and leaves nothing else behind
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	err = os.Chdir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	err = os.Mkdir("sub", 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fname := filepath.Join("sub", "data.hio")
+	write_row_file(t, fname, 10)
+
+	f, err := Open(filepath.Join(dir, fname))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := read_row_file(f, 10); err != nil {
+		t.Error(err)
+	}
+	f.Close()
+
+	for _, d := range []string{".", "sub"} {
+		fis, err := os.ReadDir(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, fi := range fis {
+			names = append(names, fi.Name())
+		}
+		want := map[string]string{".": "sub", "sub": "data.hio"}[d]
+		if len(names) != 1 || names[0] != want {
+			t.Errorf("directory %q: got %v, want [%s]", d, names, want)
+		}
+	}
+	if _, err := os.Stat("__go-hep-io__"); !os.IsNotExist(err) {
+		t.Errorf("__go-hep-io__ left in the working directory: %v", err)
+	}
+}
+
+func TestConcurrentOpen(t *testing.T) {
+	const n = 100
+	fname := filepath.Join(t.TempDir(), "rows.hio")
+	write_row_file(t, fname, n)
+
+	errc := make(chan error)
+	const nreaders = 8
+	for i := 0; i < nreaders; i++ {
+		go func() {
+			f, err := Open(fname)
+			if err != nil {
+				errc <- err
+				return
+			}
+			err = read_row_file(f, n)
+			if e := f.Close(); err == nil {
+				err = e
+			}
+			errc <- err
+		}()
+	}
+	for i := 0; i < nreaders; i++ {
+		if err := <-errc; err != nil {
+			t.Error(err)
+		}
+	}
+}
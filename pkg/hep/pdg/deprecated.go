@@ -0,0 +1,25 @@
+package pdg
+
+// Former names of constants whose garbled identifiers have been fixed
+const (
+	// Deprecated: use PDG_c_hadron.
+	PDG_c_minushadron = PDG_c_hadron
+	// Deprecated: use PDG_anti_c_hadron.
+	PDG_anti_c_minushadron = PDG_anti_c_hadron
+	// Deprecated: use PDG_b_hadron.
+	PDG_b_minushadron = PDG_b_hadron
+	// Deprecated: use PDG_anti_b_hadron.
+	PDG_anti_b_minushadron = PDG_anti_b_hadron
+	// Deprecated: use PDG_t_hadron.
+	PDG_t_minushadron = PDG_t_hadron
+	// Deprecated: use PDG_anti_t_hadron.
+	PDG_anti_t_minushadron = PDG_anti_t_hadron
+	// Deprecated: use PDG_anti_Xi_prime_c0.
+	PDG_Xi_primeanti__c0 = PDG_anti_Xi_prime_c0
+	// Deprecated: use PDG_anti_Xi_prime_c_minus.
+	PDG_Xi_primeanti__c_minus = PDG_anti_Xi_prime_c_minus
+	// Deprecated: use PDG_Sigma_b_star_plus.
+	PDG_Sigma_star_ = PDG_Sigma_b_star_plus
+	// Deprecated: use PDG_s_anti_nu_tau_L.
+	PDG_s_anti_nu_tau_Lint = PDG_s_anti_nu_tau_L
+)
@@ -0,0 +1,353 @@
+//go:build ignore
+// +build ignore
+
+// gen_names generates names_gen.go, the table of particle names, from the
+// PDG_xxx constants declared in pdg.go.
+//
+// The name of a particle is derived from its constant by replacing the
+// 'plus', 'minus', 'star' and 'prime' tokens with '+', '-', '*' and "'",
+// and the 'anti' and 's' (SUSY) prefixes, in either order, with 'anti-'
+// and '~'.
+// The LaTeX and unicode names are derived from the same tokens.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type constant struct {
+	ident string
+	id    int
+}
+
+func main() {
+	consts := parseConstants("pdg.go")
+	ids := make(map[int]bool, len(consts))
+	for _, c := range consts {
+		ids[c.id] = true
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by gen_names.go from pdg.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package pdg\n\n")
+	fmt.Fprintf(buf, "var names = []nameEntry{\n")
+	for _, c := range consts {
+		if c.id == 0 {
+			// null particles
+			continue
+		}
+		selfconj := !ids[-c.id]
+		n := newName(strings.TrimPrefix(c.ident, "PDG_"), selfconj)
+		fmt.Fprintf(buf, "\t{%s, %q, %q, %q, %q},\n",
+			c.ident, c.ident, n.ascii(), n.latex(), n.unicode())
+	}
+	fmt.Fprintf(buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("gen_names: %v", err)
+	}
+	err = ioutil.WriteFile("names_gen.go", src, 0644)
+	if err != nil {
+		log.Fatalf("gen_names: %v", err)
+	}
+}
+
+// parseConstants returns the integer constants declared in 'fname', in
+// declaration order
+func parseConstants(fname string) []constant {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, fname, nil, 0)
+	if err != nil {
+		log.Fatalf("gen_names: %v", err)
+	}
+	var consts []constant
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vspec := spec.(*ast.ValueSpec)
+			for i, ident := range vspec.Names {
+				consts = append(consts, constant{
+					ident: ident.Name,
+					id:    intValue(vspec.Values[i]),
+				})
+			}
+		}
+	}
+	return consts
+}
+
+// intValue evaluates an integer literal, possibly negated
+func intValue(expr ast.Expr) int {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		v, err := strconv.Atoi(e.Value)
+		if err != nil {
+			log.Fatalf("gen_names: %v", err)
+		}
+		return v
+	case *ast.UnaryExpr:
+		if e.Op == token.SUB {
+			return -intValue(e.X)
+		}
+	}
+	log.Fatalf("gen_names: unsupported constant expression %T", expr)
+	return 0
+}
+
+// name is the decomposition of a constant into the pieces of a particle
+// name
+type name struct {
+	anti   bool
+	susy   bool
+	base   string
+	subs   []string // subscripts
+	state  string   // radial/orbital excitation (2S, 1P, ...)
+	star   bool
+	primes int
+	charge string
+	ident  string // the constant, without the PDG_ prefix
+}
+
+func newName(ident string, selfconj bool) name {
+	n := name{ident: ident}
+	toks := strings.Split(ident, "_")
+	// the prefixes come in either order: anti_s_ or s_anti_
+prefixes:
+	for len(toks) > 1 {
+		switch toks[0] {
+		case "anti":
+			n.anti = true
+		case "s":
+			n.susy = true
+		default:
+			break prefixes
+		}
+		toks = toks[1:]
+	}
+	n.base = toks[0]
+	toks = toks[1:]
+	if n.base == "J" && len(toks) > 0 && toks[0] == "psi" {
+		n.base = "J/psi"
+		toks = toks[1:]
+	}
+	if strings.HasSuffix(n.base, "0") && len(n.base) > 1 {
+		n.base = strings.TrimSuffix(n.base, "0")
+		n.charge = "0"
+	}
+	for i, tok := range toks {
+		last := i == len(toks)-1
+		switch {
+		case tok == "plus":
+			n.charge += "+"
+		case tok == "minus":
+			n.charge += "-"
+		case tok == "star":
+			n.star = true
+		case tok == "star0":
+			n.star = true
+			n.charge = "0"
+		case tok == "prime":
+			n.primes++
+		case tok == "prime0":
+			// Z_prime0, Higgs_prime0
+			n.primes++
+			n.charge = "0"
+		case isState(tok):
+			n.state = tok
+		case last && len(tok) > 1 && strings.HasSuffix(tok, "0") && (isDigits(tok) || !selfconj):
+			// a_10, Xi_c0, B_s10: the trailing 0 is the charge.
+			// Self-conjugate states (chi_c0) carry J in their subscript.
+			n.subs = append(n.subs, strings.TrimSuffix(tok, "0"))
+			n.charge = "0"
+		default:
+			n.subs = append(n.subs, tok)
+		}
+	}
+	return n
+}
+
+// isState returns whether the token denotes an excitation, like 2S or 1D
+func isState(tok string) bool {
+	return len(tok) == 2 && unicode.IsDigit(rune(tok[0])) && strings.ContainsRune("SPD", rune(tok[1]))
+}
+
+func isDigits(tok string) bool {
+	for _, c := range tok {
+		if !unicode.IsDigit(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// ascii returns the name of the particle, e.g. "anti-K*0" or "Lambda_c+"
+func (n name) ascii() string {
+	s := n.ident
+	for _, r := range []struct{ old, new string }{
+		{"_plus", "+"},
+		{"_minus", "-"},
+		{"_star", "*"},
+		{"_prime", "'"},
+	} {
+		s = strings.Replace(s, r.old, r.new, -1)
+	}
+	switch {
+	case n.anti && n.susy:
+		s = strings.TrimPrefix(s, "anti_s_")
+		s = "anti-~" + strings.TrimPrefix(s, "s_anti_")
+	case n.anti:
+		s = "anti-" + strings.TrimPrefix(s, "anti_")
+	case n.susy:
+		s = "~" + strings.TrimPrefix(s, "s_")
+	}
+	if n.base == "J/psi" {
+		s = strings.Replace(s, "J_psi", "J/psi", 1)
+	}
+	return s
+}
+
+// symbols maps the bases and subscripts of names to their LaTeX and
+// unicode renderings
+var symbols = map[string]struct{ latex, unicode string }{
+	"Higgs":   {"H", "H"},
+	"alpha":   {`\alpha`, "α"},
+	"gamma":   {`\gamma`, "γ"},
+	"Delta":   {`\Delta`, "Δ"},
+	"eta":     {`\eta`, "η"},
+	"Lambda":  {`\Lambda`, "Λ"},
+	"mu":      {`\mu`, "μ"},
+	"nu":      {`\nu`, "ν"},
+	"Xi":      {`\Xi`, "Ξ"},
+	"pi":      {`\pi`, "π"},
+	"rho":     {`\rho`, "ρ"},
+	"Sigma":   {`\Sigma`, "Σ"},
+	"tau":     {`\tau`, "τ"},
+	"Upsilon": {`\Upsilon`, "Υ"},
+	"phi":     {`\phi`, "φ"},
+	"chi":     {`\chi`, "χ"},
+	"psi":     {`\psi`, "ψ"},
+	"J/psi":   {`J/\psi`, "J/ψ"},
+	"omega":   {`\omega`, "ω"},
+	"Omega":   {`\Omega`, "Ω"},
+}
+
+// subscript renders a list of subscripts, converting symbols letters
+func subscript(subs []string, latex bool) string {
+	o := make([]string, len(subs))
+	for i, s := range subs {
+		if g, ok := symbols[s]; ok {
+			if latex {
+				s = g.latex
+			} else {
+				s = g.unicode
+			}
+		}
+		o[i] = s
+	}
+	return strings.Join(o, ",")
+}
+
+// latex returns the LaTeX name of the particle, e.g. \bar{K}^{*0}
+func (n name) latex() string {
+	base := n.base
+	if g, ok := symbols[base]; ok {
+		base = g.latex
+	}
+	if n.susy {
+		base = `\tilde{` + base + `}`
+	}
+	if n.anti {
+		base = `\bar{` + base + `}`
+	}
+	s := base + strings.Repeat("'", n.primes)
+	if len(n.subs) > 0 {
+		s += "_{" + subscript(n.subs, true) + "}"
+	}
+	sup := n.charge
+	if n.star {
+		sup = "*" + sup
+	}
+	if sup != "" {
+		s += "^{" + sup + "}"
+	}
+	if n.state != "" {
+		s += "(" + n.state + ")"
+	}
+	return s
+}
+
+var (
+	supers = map[rune]rune{
+		'+': '⁺', '-': '⁻', '0': '⁰', '*': '*',
+	}
+	subs = map[rune]rune{
+		'0': '₀', '1': '₁', '2': '₂', '3': '₃', '4': '₄',
+		'5': '₅', '6': '₆', '7': '₇', '8': '₈', '9': '₉',
+		'a': 'ₐ', 'e': 'ₑ', 'h': 'ₕ', 'k': 'ₖ', 'l': 'ₗ',
+		'm': 'ₘ', 'n': 'ₙ', 'o': 'ₒ', 'p': 'ₚ', 's': 'ₛ',
+		't': 'ₜ', 'x': 'ₓ', ',': ',',
+	}
+)
+
+// convert maps the runes of 's' through 'm', if all of them have a
+// mapping. Otherwise 's' is returned unchanged, and false.
+func convert(s string, m map[rune]rune) (string, bool) {
+	o := make([]rune, 0, len(s))
+	for _, c := range s {
+		r, ok := m[c]
+		if !ok {
+			return s, false
+		}
+		o = append(o, r)
+	}
+	return string(o), true
+}
+
+// unicode returns the unicode name of the particle, e.g. K̅*⁰
+func (n name) unicode() string {
+	base := n.base
+	if g, ok := symbols[base]; ok {
+		base = g.unicode
+	}
+	if n.susy {
+		// combining tilde
+		base += "̃"
+	}
+	if n.anti {
+		// combining overline
+		base += "̅"
+	}
+	s := base + strings.Repeat("′", n.primes)
+	if len(n.subs) > 0 {
+		// subscripts without a unicode rendering are kept as _xxx
+		sub, ok := convert(subscript(n.subs, false), subs)
+		if !ok {
+			sub = "_" + sub
+		}
+		s += sub
+	}
+	sup := n.charge
+	if n.star {
+		sup = "*" + sup
+	}
+	sup, _ = convert(sup, supers)
+	s += sup
+	if n.state != "" {
+		s += "(" + n.state + ")"
+	}
+	return s
+}
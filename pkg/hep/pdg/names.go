@@ -0,0 +1,149 @@
+package pdg
+
+//go:generate go run gen_names.go
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// nameEntry holds the names of a particle, generated from its PDG_xxx
+// constant
+type nameEntry struct {
+	id      int
+	ident   string // Go identifier (PDG_xxx)
+	name    string // e.g. "anti-K*0"
+	latex   string // e.g. `\bar{K}^{*0}`
+	unicode string // e.g. "K̅*⁰"
+}
+
+// aliases are additional common names of particles
+var aliases = map[string]int{
+	"d":        PDG_d,
+	"u":        PDG_u,
+	"s":        PDG_s,
+	"c":        PDG_c,
+	"b":        PDG_b,
+	"t":        PDG_t,
+	"top":      PDG_t,
+	"gluon":    PDG_g,
+	"photon":   PDG_gamma,
+	"electron": PDG_e_minus,
+	"positron": PDG_e_plus,
+	"muon":     PDG_mu_minus,
+	"Z":        PDG_Z0,
+	"Higgs":    PDG_Higgs0,
+	"H":        PDG_Higgs0,
+	"p":        PDG_p_plus,
+	"proton":   PDG_p_plus,
+	"pbar":     PDG_anti_p_minus,
+	"n":        PDG_n0,
+	"neutron":  PDG_n0,
+	"nbar":     PDG_anti_n0,
+	"Jpsi":     PDG_J_psi,
+	"K_S":      PDG_K_S0,
+	"KS":       PDG_K_S0,
+	"K_L":      PDG_K_L0,
+	"KL":       PDG_K_L0,
+	"Lambda":   PDG_Lambda0,
+}
+
+var (
+	namesOnce sync.Once
+	byName    map[string]int
+	byID      map[int]*nameEntry
+)
+
+// initNames builds the name <-> id lookup tables.
+// The names of the particle data table are added first, then the aliases,
+// then the generated names and Go identifiers: a name present in several
+// of them resolves to the id of the last one added.
+func initNames() {
+	byName = make(map[string]int, 3*len(names))
+	byID = make(map[int]*nameEntry, len(names))
+	if db, err := particles(); err == nil {
+		for id, p := range db {
+			byName[p.Name] = id
+		}
+	}
+	for k, v := range aliases {
+		byName[k] = v
+	}
+	for i := range names {
+		e := &names[i]
+		byName[e.ident] = e.id
+		byName[e.name] = e.id
+		if _, dup := byID[e.id]; !dup {
+			byID[e.id] = e
+		}
+	}
+}
+
+// ByName returns the PDG MC id of the particle named 'name'.
+// Accepted names are the particle names (e.g. "mu-", "B_s0", "K*0"), the Go
+// identifiers of the constants (e.g. "PDG_mu_minus"), the names of the
+// particle data table (e.g. "psi(2S)") and common aliases (e.g. "proton").
+// An "anti-" prefix denotes the antiparticle of a named particle, e.g.
+// "anti-Lambda_c+".
+func ByName(name string) (int, error) {
+	namesOnce.Do(initNames)
+	if id, ok := byName[name]; ok {
+		return id, nil
+	}
+	if strings.HasPrefix(name, "anti-") {
+		id, err := ByName(name[len("anti-"):])
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := byID[-id]; ok {
+			return -id, nil
+		}
+		if db, err := particles(); err == nil {
+			if _, ok := db[-id]; ok {
+				return -id, nil
+			}
+		}
+		return 0, fmt.Errorf("pdg: %q has no antiparticle: %w", name[len("anti-"):], ErrUnknownParticle)
+	}
+	return 0, fmt.Errorf("pdg: name %q: %w", name, ErrUnknownParticle)
+}
+
+// Name returns the name of the particle 'id', e.g. "mu-".
+// The decimal id is returned for unknown particles.
+func Name(id int) string {
+	namesOnce.Do(initNames)
+	if e, ok := byID[id]; ok {
+		return e.name
+	}
+	if db, err := particles(); err == nil {
+		if p, ok := db[id]; ok {
+			return p.Name
+		}
+	}
+	return strconv.Itoa(id)
+}
+
+// LaTeX returns the LaTeX name of the particle 'id', for plot labels, e.g.
+// `\Lambda_{c}^{+}`. The name of the particle is returned if there is no
+// LaTeX name for it.
+func LaTeX(id int) string {
+	namesOnce.Do(initNames)
+	if e, ok := byID[id]; ok {
+		return e.latex
+	}
+	return Name(id)
+}
+
+// Unicode returns the unicode name of the particle 'id', for plot labels,
+// e.g. "π⁰" or "Λ_c⁺": subscripts without unicode characters are kept
+// after an underscore. The name of the particle is returned if there is no
+// unicode name for it.
+func Unicode(id int) string {
+	namesOnce.Do(initNames)
+	if e, ok := byID[id]; ok {
+		return e.unicode
+	}
+	return Name(id)
+}
@@ -0,0 +1,65 @@
+package pdg
+
+import (
+	"testing"
+)
+
+func TestNames(t *testing.T) {
+	for _, c := range []struct {
+		id                   int
+		name, latex, unicode string
+	}{
+		{PDG_mu_minus, "mu-", `\mu^{-}`, "μ⁻"},
+		{PDG_anti_Lambda_c_minus, "anti-Lambda_c-", `\bar{\Lambda}_{c}^{-}`, "Λ̅_c⁻"},
+		{PDG_Upsilon_2S, "Upsilon_2S", `\Upsilon(2S)`, "Υ(2S)"},
+		{PDG_h_b_3P, "h_b_3P", `h_{b}(3P)`, "h_b(3P)"},
+		{PDG_c_hadron, "c_hadron", `c_{hadron}`, "c_hadron"},
+		{PDG_nu_mu, "nu_mu", `\nu_{\mu}`, "ν_μ"},
+		{PDG_Z_prime0, "Z'0", `Z'^{0}`, "Z′⁰"},
+		{PDG_Z_prime_prime0, "Z''0", `Z''^{0}`, "Z′′⁰"},
+		{PDG_Higgs_prime0, "Higgs'0", `H'^{0}`, "H′⁰"},
+		{PDG_W_prime_minus, "W'-", `W'^{-}`, "W′⁻"},
+		{PDG_Xi_prime_c0, "Xi'_c0", `\Xi'_{c}^{0}`, "Ξ′_c⁰"},
+		{PDG_s_nu_tau_L, "~nu_tau_L", `\tilde{\nu}_{\tau,L}`, "ν̃_τ,L"},
+		{PDG_s_anti_nu_tau_L, "anti-~nu_tau_L", `\bar{\tilde{\nu}}_{\tau,L}`, "ν̃̅_τ,L"},
+	} {
+		if got := Name(c.id); got != c.name {
+			t.Errorf("Name(%d) = %q, want %q", c.id, got, c.name)
+		}
+		if got := LaTeX(c.id); got != c.latex {
+			t.Errorf("LaTeX(%d) = %q, want %q", c.id, got, c.latex)
+		}
+		if got := Unicode(c.id); got != c.unicode {
+			t.Errorf("Unicode(%d) = %q, want %q", c.id, got, c.unicode)
+		}
+	}
+}
+
+func TestByName(t *testing.T) {
+	for _, e := range names {
+		for _, name := range []string{e.ident, Name(e.id)} {
+			id, err := ByName(name)
+			if err != nil {
+				t.Errorf("ByName(%q): %v", name, err)
+				continue
+			}
+			if id != e.id {
+				t.Errorf("ByName(%q) = %d, want %d", name, id, e.id)
+			}
+		}
+	}
+	for _, c := range []struct {
+		name string
+		id   int
+	}{
+		{"proton", PDG_p_plus},
+		{"anti-Lambda_c+", PDG_anti_Lambda_c_minus},
+		{"psi(2S)", PDG_psi_2S},
+		{"~tau_1-", PDG_s_tau_minus_1},
+	} {
+		id, err := ByName(c.name)
+		if err != nil || id != c.id {
+			t.Errorf("ByName(%q) = %d, %v, want %d", c.name, id, err, c.id)
+		}
+	}
+}
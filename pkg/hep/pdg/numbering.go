@@ -0,0 +1,407 @@
+package pdg
+
+// Decoding of particle ids following the Monte Carlo particle numbering
+// scheme (see the "Monte Carlo particle numbering scheme" review of the
+// Review of Particle Physics).
+//
+// A particle id is a signed integer whose absolute value is made of the
+// digits
+//  n10 n9 n8 n nr nl nq1 nq2 nq3 nj
+// where nj is the spin multiplicity (2J+1), nq1-nq3 the quark content and
+// n, nr, nl, n8-n10 extra quantum numbers. Nuclear codes are 10-digit
+// numbers of the form 10LZZZAAAI.
+
+// locations of the digits of a particle id
+const (
+	locNj  = 1
+	locNq3 = 2
+	locNq2 = 3
+	locNq1 = 4
+	locNl  = 5
+	locNr  = 6
+	locN   = 7
+	locN8  = 8
+	locN9  = 9
+	locN10 = 10
+)
+
+// three times the electric charge of the fundamental particles, indexed by
+// (id-1)
+var fundamentalThreeCharge = [100]int{
+	-1, 2, -1, 2, -1, 2, -1, 2, 0, 0,
+	-3, 0, -3, 0, -3, 0, -3, 0, 0, 0,
+	0, 0, 0, 3, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 3, 0, 0, 3, 0, 0, 0,
+	0, -1, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 6, 3, 6, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+}
+
+func abs(id int) int {
+	if id < 0 {
+		return -id
+	}
+	return id
+}
+
+// digit returns the digit at location 'loc' of the particle id
+func digit(id, loc int) int {
+	v := abs(id)
+	for i := 1; i < loc; i++ {
+		v /= 10
+	}
+	return v % 10
+}
+
+// extraBits returns the digits beyond the 7th one
+func extraBits(id int) int {
+	return abs(id) / 10000000
+}
+
+// fundamentalID returns the id of the fundamental particle (the last 4
+// digits) if 'id' does not describe a composite particle, 0 otherwise
+func fundamentalID(id int) int {
+	if digit(id, locN10) == 1 && digit(id, locN9) == 0 {
+		return 0
+	}
+	if digit(id, locNq2) == 0 && digit(id, locNq1) == 0 {
+		return abs(id) % 10000
+	}
+	return 0
+}
+
+// Returns whether 'id' is a quark (including 4th generation quarks)
+func IsQuark(id int) bool {
+	aid := abs(id)
+	return aid >= 1 && aid <= 8
+}
+
+// Returns whether 'id' is a lepton (including 4th generation leptons)
+func IsLepton(id int) bool {
+	aid := abs(id)
+	return aid >= 11 && aid <= 18
+}
+
+// Returns whether 'id' is a meson
+func IsMeson(id int) bool {
+	aid := abs(id)
+	switch {
+	case extraBits(id) > 0:
+		return false
+	case aid <= 100:
+		return false
+	}
+	if fid := fundamentalID(id); fid > 0 && fid <= 100 {
+		return false
+	}
+	switch aid {
+	case 130, 310, 210:
+		return true
+	case 150, 350, 510, 530:
+		// B0-B0bar and Bs-Bsbar mixtures
+		return true
+	case 110, 990, 9990:
+		// reggeon and pomerons
+		return false
+	}
+	if digit(id, locNj) > 0 && digit(id, locNq3) > 0 && digit(id, locNq2) > 0 &&
+		digit(id, locNq1) == 0 {
+		// quarkonia are their own antiparticles
+		if digit(id, locNq3) == digit(id, locNq2) && id < 0 {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// Returns whether 'id' is a baryon
+func IsBaryon(id int) bool {
+	aid := abs(id)
+	switch {
+	case extraBits(id) > 0:
+		return false
+	case aid <= 100:
+		return false
+	}
+	if fid := fundamentalID(id); fid > 0 && fid <= 100 {
+		return false
+	}
+	switch aid {
+	case 2110, 2210:
+		// diffractive states
+		return true
+	}
+	return digit(id, locNj) > 0 && digit(id, locNq3) > 0 &&
+		digit(id, locNq2) > 0 && digit(id, locNq1) > 0
+}
+
+// Returns whether 'id' is a diquark
+func IsDiQuark(id int) bool {
+	switch {
+	case extraBits(id) > 0:
+		return false
+	case abs(id) <= 100:
+		return false
+	}
+	if fid := fundamentalID(id); fid > 0 && fid <= 100 {
+		return false
+	}
+	return digit(id, locNj) > 0 && digit(id, locNq3) == 0 &&
+		digit(id, locNq2) > 0 && digit(id, locNq1) > 0
+}
+
+// Returns whether 'id' is a hadron (a meson or a baryon, including
+// R-hadrons)
+func IsHadron(id int) bool {
+	return IsMeson(id) || IsBaryon(id)
+}
+
+// Returns whether 'id' is a supersymmetric particle
+func IsSUSY(id int) bool {
+	if extraBits(id) > 0 {
+		return false
+	}
+	if n := digit(id, locN); n != 1 && n != 2 {
+		return false
+	}
+	if digit(id, locNr) != 0 {
+		return false
+	}
+	return fundamentalID(id) != 0
+}
+
+// Returns whether 'id' is an R-hadron: a hadron made of a squark or a
+// gluino and light quarks or gluons
+func IsRHadron(id int) bool {
+	switch {
+	case extraBits(id) > 0:
+		return false
+	case digit(id, locN) != 1:
+		return false
+	case digit(id, locNr) != 0:
+		return false
+	case IsSUSY(id):
+		return false
+	}
+	return digit(id, locNq2) != 0 && digit(id, locNq3) != 0 && digit(id, locNj) != 0
+}
+
+// Returns whether 'id' is a nucleus (including the proton)
+func IsNucleus(id int) bool {
+	if abs(id) == 2212 {
+		return true
+	}
+	if digit(id, locN10) == 1 && digit(id, locN9) == 0 {
+		a := A(id)
+		return a > 0 && a >= abs(Z(id))
+	}
+	return false
+}
+
+// Returns the mass number of a nucleus, 0 for other particles
+func A(id int) int {
+	aid := abs(id)
+	if aid == 2212 {
+		return 1
+	}
+	if digit(id, locN10) != 1 || digit(id, locN9) != 0 {
+		return 0
+	}
+	return (aid / 10) % 1000
+}
+
+// Returns the atomic number of a nucleus, 0 for other particles.
+// Anti-nuclei have a negative atomic number.
+func Z(id int) int {
+	aid := abs(id)
+	z := 0
+	switch {
+	case aid == 2212:
+		z = 1
+	case digit(id, locN10) == 1 && digit(id, locN9) == 0:
+		z = (aid / 10000) % 1000
+	}
+	if id < 0 {
+		return -z
+	}
+	return z
+}
+
+// Returns the valence quark content of a hadron, a diquark or a quark.
+// Antiquarks have negative ids. Mesons list the quark first.
+// nil is returned for other particles, including R-hadrons.
+func Quarks(id int) []int {
+	var qs []int
+	q1 := digit(id, locNq1)
+	q2 := digit(id, locNq2)
+	q3 := digit(id, locNq3)
+	switch {
+	case IsQuark(id):
+		return []int{id}
+	case extraBits(id) > 0 || IsRHadron(id) || IsSUSY(id):
+		return nil
+	case IsBaryon(id) && q1 > 0:
+		qs = []int{q1, q2, q3}
+	case IsDiQuark(id):
+		qs = []int{q1, q2}
+	case IsMeson(id) && q2 > 0:
+		// nq2 is the heavier flavour: a quark if it is up-type, an
+		// antiquark if it is down-type
+		if q2%2 == 0 {
+			qs = []int{q2, -q3}
+		} else {
+			qs = []int{q3, -q2}
+		}
+	default:
+		return nil
+	}
+	if id < 0 {
+		for i := range qs {
+			qs[i] = -qs[i]
+		}
+	}
+	return qs
+}
+
+// hasQuark returns whether the valence content of 'id' contains the
+// (anti)quark of flavour 'q'
+func hasQuark(id, q int) bool {
+	if extraBits(id) > 0 || fundamentalID(id) > 0 {
+		return false
+	}
+	if IsRHadron(id) {
+		for _, loc := range []int{locNl, locNq1, locNq2, locNq3} {
+			if digit(id, loc) == q {
+				return true
+			}
+		}
+		return false
+	}
+	return digit(id, locNq1) == q || digit(id, locNq2) == q || digit(id, locNq3) == q
+}
+
+// Returns whether the hadron 'id' contains a strange (anti)quark
+func HasStrange(id int) bool {
+	return hasQuark(id, 3)
+}
+
+// Returns whether the hadron 'id' contains a charm (anti)quark
+func HasCharm(id int) bool {
+	return hasQuark(id, 4)
+}
+
+// Returns whether the hadron 'id' contains a bottom (anti)quark
+func HasBottom(id int) bool {
+	return hasQuark(id, 5)
+}
+
+// Returns three times the electric charge of the particle, in units of
+// the positron charge
+func ThreeCharge(id int) int {
+	aid := abs(id)
+	fid := fundamentalID(id)
+	q1 := digit(id, locNq1)
+	q2 := digit(id, locNq2)
+	q3 := digit(id, locNq3)
+	ch := func(q int) int {
+		return fundamentalThreeCharge[q-1]
+	}
+	charge := 0
+	switch {
+	case aid == 0:
+		return 0
+	case IsNucleus(id):
+		return 3 * Z(id)
+	case extraBits(id) > 0:
+		return 0
+	case fid > 0 && fid <= 100:
+		charge = ch(fid)
+		switch aid {
+		case 5100061, 5100062, 9900041, 9900042:
+			// doubly charged technipions and left-right higgs bosons
+			charge = 6
+		}
+	case aid == 210 || aid == 2210:
+		// diffractive pi+ and p
+		charge = 3
+	case digit(id, locNj) == 0:
+		return 0
+	case IsRHadron(id):
+		// the gluino and gluons (9) do not contribute
+		var qs []int
+		for _, loc := range []int{locNl, locNq1, locNq2, locNq3} {
+			if q := digit(id, loc); q != 0 && q != 9 {
+				qs = append(qs, q)
+			}
+		}
+		switch len(qs) {
+		case 2:
+			if qs[0] == 3 || qs[0] == 5 {
+				charge = ch(qs[1]) - ch(qs[0])
+			} else {
+				charge = ch(qs[0]) - ch(qs[1])
+			}
+		case 3:
+			charge = ch(qs[0]) + ch(qs[1]) + ch(qs[2])
+		}
+	case IsMeson(id):
+		if q2 == 3 || q2 == 5 {
+			charge = ch(q3) - ch(q2)
+		} else {
+			charge = ch(q2) - ch(q3)
+		}
+	case IsDiQuark(id):
+		charge = ch(q1) + ch(q2)
+	case IsBaryon(id):
+		charge = ch(q3) + ch(q2) + ch(q1)
+	}
+	if id < 0 {
+		charge = -charge
+	}
+	return charge
+}
+
+// Returns the spin multiplicity 2J+1 of the particle, or 0 if it is not
+// defined
+func JSpin(id int) int {
+	if fid := fundamentalID(id); fid > 0 && fid <= 100 {
+		if IsSUSY(id) {
+			// sfermions are scalars, gauginos and higgsinos fermions
+			switch {
+			case fid < 20:
+				return 1
+			case fid == 39:
+				// gravitino
+				return 4
+			case fid < 40:
+				return 2
+			}
+			return 0
+		}
+		switch {
+		case fid > 0 && fid < 9:
+			return 2
+		case fid == 9:
+			return 3
+		case fid > 10 && fid < 19:
+			return 2
+		case fid > 20 && fid < 25:
+			return 3
+		case fid == 25:
+			return 1
+		}
+		return 0
+	}
+	switch {
+	case extraBits(id) > 0:
+		return 0
+	case abs(id) == 130 || abs(id) == 310:
+		// K_L0 and K_S0
+		return 1
+	}
+	return abs(id) % 10
+}
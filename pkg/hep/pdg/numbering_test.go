@@ -0,0 +1,91 @@
+package pdg
+
+import (
+	"math"
+	"testing"
+)
+
+// TestNumberingTable checks the decoding of the id of every constant
+// against the properties of the particle data table
+func TestNumberingTable(t *testing.T) {
+	for _, e := range names {
+		id := e.id
+		if aid := abs(id); aid > 80 && aid < 100 {
+			// generator-specific codes
+			continue
+		}
+		p, err := Lookup(id)
+		if err != nil {
+			t.Errorf("%s: %v", e.ident, err)
+			continue
+		}
+
+		if got, want := ThreeCharge(id), int(math.Round(3*p.Charge)); got != want {
+			t.Errorf("%s: ThreeCharge = %d, want %d", e.ident, got, want)
+		}
+		if j := JSpin(id); j > 0 && float64(j) != 2*p.Spin+1 {
+			t.Errorf("%s: JSpin = %d, want %v", e.ident, j, 2*p.Spin+1)
+		}
+
+		meson, baryon, diquark := IsMeson(id), IsBaryon(id), IsDiQuark(id)
+		// the spin of diffractive states is not defined
+		spin := JSpin(id) > 0
+		halfInt := math.Mod(2*p.Spin, 2) == 1
+		switch {
+		case meson && baryon:
+			t.Errorf("%s: both a meson and a baryon", e.ident)
+		case spin && meson && halfInt:
+			t.Errorf("%s: meson with spin %v", e.ident, p.Spin)
+		case spin && baryon && !halfInt:
+			t.Errorf("%s: baryon with spin %v", e.ident, p.Spin)
+		case spin && diquark && halfInt:
+			t.Errorf("%s: diquark with spin %v", e.ident, p.Spin)
+		case !meson && !baryon && !diquark && abs(id) > 100 && !IsSUSY(id) && abs(id) < 9000000:
+			t.Errorf("%s: neither a meson, a baryon nor a diquark", e.ident)
+		}
+		if !meson && !baryon && !diquark {
+			continue
+		}
+
+		qs := Quarks(id)
+		want := 2
+		switch {
+		case baryon:
+			want = 3
+		case abs(id) == 130 || abs(id) == 310:
+			// K_L0 and K_S0 mix K0 and K0bar
+			continue
+		}
+		if len(qs) != want {
+			t.Errorf("%s: Quarks = %v, want %d quarks", e.ident, qs, want)
+			continue
+		}
+		charge := 0
+		nq := 0
+		for _, q := range qs {
+			charge += ThreeCharge(q)
+			if q > 0 {
+				nq++
+			} else {
+				nq--
+			}
+		}
+		if want := int(math.Round(3 * p.Charge)); charge != want {
+			t.Errorf("%s: Quarks = %v, with a charge of %d/3, want %d/3", e.ident, qs, charge, want)
+		}
+		// baryon number, times 3
+		wantnq := 0
+		switch {
+		case baryon:
+			wantnq = 3
+		case diquark:
+			wantnq = 2
+		}
+		if id < 0 {
+			wantnq = -wantnq
+		}
+		if nq != wantnq {
+			t.Errorf("%s: Quarks = %v, want a baryon number of %d/3", e.ident, qs, wantnq)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package pdg
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// hbar is the reduced Planck constant, in GeV.s
+const hbar = 6.582119569e-25
+
+// ErrUnknownParticle is returned when looking up an id missing from the
+// particle data table
+var ErrUnknownParticle = errors.New("pdg: unknown particle")
+
+// Particle holds the properties of a particle species
+type Particle struct {
+	ID     int     // PDG MC id
+	Name   string  // particle name
+	Mass   float64 // mass, in GeV
+	Width  float64 // total width, in GeV
+	Charge float64 // electric charge, in units of the positron charge
+	Spin   float64 // spin J
+	AntiID int     // PDG MC id of the antiparticle (ID if self-conjugate)
+}
+
+// Returns the mean lifetime in seconds, hbar/width.
+// Particles with a zero width have an infinite lifetime.
+func (p *Particle) Lifetime() float64 {
+	if p.Width == 0 {
+		return math.Inf(1)
+	}
+	return hbar / p.Width
+}
+
+// Returns whether the particle is its own antiparticle
+func (p *Particle) SelfConjugate() bool {
+	return p.ID == p.AntiID
+}
+
+func (p *Particle) String() string {
+	return fmt.Sprintf("%s (id=%d, mass=%v GeV, width=%v GeV, charge=%v, spin=%v)",
+		p.Name, p.ID, p.Mass, p.Width, p.Charge, p.Spin)
+}
+
+// Returns the properties of the particle with PDG MC id 'id'.
+// The returned value is a copy and may be modified freely.
+func Lookup(id int) (*Particle, error) {
+	db, err := particles()
+	if err != nil {
+		return nil, err
+	}
+	p, ok := db[id]
+	if !ok {
+		return nil, fmt.Errorf("id %d: %w", id, ErrUnknownParticle)
+	}
+	o := *p
+	return &o, nil
+}
+
+var (
+	dbOnce sync.Once
+	dbData map[int]*Particle
+	dbErr  error
+)
+
+// particles returns the particle data table, parsing it on first use
+func particles() (map[int]*Particle, error) {
+	dbOnce.Do(func() {
+		dbData, dbErr = parseTable(table)
+	})
+	return dbData, dbErr
+}
+
+// parseTable parses a particle data table (see table for the format)
+func parseTable(txt string) (map[int]*Particle, error) {
+	db := make(map[int]*Particle)
+	scan := bufio.NewScanner(strings.NewReader(txt))
+	iline := 0
+	for scan.Scan() {
+		iline++
+		line := strings.TrimSpace(scan.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 7 {
+			return nil, fmt.Errorf("pdg: table line %d: invalid number of fields (%d)", iline, len(fields))
+		}
+		var vs [5]float64
+		for i := range vs {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("pdg: table line %d: %v", iline, err)
+			}
+			vs[i] = v
+		}
+		p := &Particle{
+			ID:     int(vs[0]),
+			Name:   fields[5],
+			Mass:   vs[1],
+			Width:  vs[2],
+			Charge: vs[3] / 3,
+			Spin:   vs[4] / 2,
+			AntiID: int(vs[0]),
+		}
+		if _, dup := db[p.ID]; dup {
+			return nil, fmt.Errorf("pdg: table line %d: duplicate id %d", iline, p.ID)
+		}
+		db[p.ID] = p
+		if anti := fields[6]; anti != "-" {
+			p.AntiID = -p.ID
+			db[-p.ID] = &Particle{
+				ID:     -p.ID,
+				Name:   anti,
+				Mass:   p.Mass,
+				Width:  p.Width,
+				Charge: -p.Charge,
+				Spin:   p.Spin,
+				AntiID: p.ID,
+			}
+		}
+	}
+	return db, scan.Err()
+}
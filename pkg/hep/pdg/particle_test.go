@@ -0,0 +1,84 @@
+package pdg
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestLookupConstants(t *testing.T) {
+	idents := make(map[int]string, len(names))
+	for _, e := range names {
+		if prev, dup := idents[e.id]; dup {
+			t.Errorf("%s and %s share the id %d", prev, e.ident, e.id)
+		}
+		idents[e.id] = e.ident
+		p, err := Lookup(e.id)
+		if err != nil {
+			t.Errorf("%s: %v", e.ident, err)
+			continue
+		}
+		if _, ok := idents[-e.id]; ok || p.SelfConjugate() {
+			continue
+		}
+		if _, err := Lookup(p.AntiID); err != nil {
+			t.Errorf("antiparticle of %s: %v", e.ident, err)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, c := range []struct {
+		id     int
+		name   string
+		charge float64
+	}{
+		{PDG_Upsilon_2S, "Upsilon(2S)", 0},
+		{PDG_h_b_3P, "h_b(3P)", 0},
+		{PDG_c_hadron, "c-hadron", 0},
+		{PDG_s_tau_minus_1, "~tau_1-", -1},
+		{PDG_s_tau_plus_1, "~tau_1+", 1},
+		{PDG_s_nu_tau_L, "~nu_tauL", 0},
+		{PDG_s_anti_nu_tau_L, "~nu_tauLbar", 0},
+	} {
+		p, err := Lookup(c.id)
+		if err != nil {
+			t.Errorf("Lookup(%d): %v", c.id, err)
+			continue
+		}
+		if p.Name != c.name || p.Charge != c.charge {
+			t.Errorf("Lookup(%d): got %s with charge %v, want %s with charge %v",
+				c.id, p.Name, p.Charge, c.name, c.charge)
+		}
+	}
+
+	// spot checks of the masses, widths and lifetimes
+	for _, c := range []struct {
+		id                    int
+		mass, width, lifetime float64
+	}{
+		{PDG_mu_minus, 0.1056583755, 2.995984e-19, 2.1969811e-6},
+		{PDG_pi_plus, 0.13957039, 2.528375e-17, 2.6033e-8},
+		{PDG_Z0, 91.1876, 2.4952, 2.6379e-25},
+		{PDG_Upsilon_2S, 10.02326, 3.198e-05, 2.0582e-20},
+		{PDG_p_plus, 0.9382720882, 0, math.Inf(1)},
+	} {
+		p, err := Lookup(c.id)
+		if err != nil {
+			t.Errorf("Lookup(%d): %v", c.id, err)
+			continue
+		}
+		if p.Mass != c.mass || p.Width != c.width {
+			t.Errorf("%s: got mass %v and width %v, want %v and %v",
+				p.Name, p.Mass, p.Width, c.mass, c.width)
+		}
+		if tau := p.Lifetime(); !(tau == c.lifetime || math.Abs(tau-c.lifetime) < 1e-4*c.lifetime) {
+			t.Errorf("%s: got lifetime %v s, want %v s", p.Name, tau, c.lifetime)
+		}
+	}
+
+	_, err := Lookup(123456789)
+	if !errors.Is(err, ErrUnknownParticle) {
+		t.Errorf("Lookup of an unknown id: got error %v, want ErrUnknownParticle", err)
+	}
+}
@@ -55,12 +55,12 @@ const (
     PDG_rndmflav int = 82
     PDG_anti_rndmflav int = -82
     PDG_phasespa int = 83
-    PDG_c_minushadron int = 84
-    PDG_anti_c_minushadron int = -84
-    PDG_b_minushadron int = 85
-    PDG_anti_b_minushadron int = -85
-    PDG_t_minushadron int = 86
-    PDG_anti_t_minushadron int = -86
+    PDG_c_hadron int = 84
+    PDG_anti_c_hadron int = -84
+    PDG_b_hadron int = 85
+    PDG_anti_b_hadron int = -85
+    PDG_t_hadron int = 86
+    PDG_anti_t_hadron int = -86
     PDG_Wvirt_plus int = 89
     PDG_Wvirt_minus int = -89
     PDG_diquark int = 90
@@ -237,13 +237,13 @@ const (
     PDG_eta_b_2S int = 20551
     PDG_eta_b_3S int = 40551
     PDG_Upsilon int = 553
-    PDG_Upsilon_2S int = 20553
+    PDG_Upsilon_2S int = 100553
     PDG_Upsilon_3S int = 60553
     PDG_Upsilon_4S int = 70553
     PDG_Upsilon_5S int = 80553
     PDG_h_b int = 10553
     PDG_h_b_2P int = 40553
-    PDG_h_b_3P int = 100553
+    PDG_h_b_3P int = 210553
     PDG_chi_b0 int = 551
     PDG_chi_b1 int = 20553
     PDG_chi_b2 int = 555
@@ -320,11 +320,11 @@ const (
     PDG_Xi_c_plus int = 4322
     PDG_anti_Xi_c_minus int = -4322
     PDG_Xi_prime_c0 int = 4312
-    PDG_Xi_primeanti__c0 int = -4312
+    PDG_anti_Xi_prime_c0 int = -4312
     PDG_Xi_c_star0 int = 4314
     PDG_anti_Xi_c_star0 int = -4314
     PDG_Xi_prime_c_plus int = 4232
-    PDG_Xi_primeanti__c_minus int = -4232
+    PDG_anti_Xi_prime_c_minus int = -4232
     PDG_Xi_c_star_plus int = 4324
     PDG_anti_Xi_c_star_minus int = -4324
     PDG_Omega_c0 int = 4332
@@ -345,7 +345,7 @@ const (
     PDG_anti_Sigma_b_star0 int = -5214
     PDG_Sigma_b_plus int = 5222
     PDG_anti_Sigma_b_minus int = -5222
-    PDG_Sigma_star_ int = 5224
+    PDG_Sigma_b_star_plus int = 5224
     PDG_anti_Sigma_b_star_minus int = -5224
     PDG_Xi_b0 int = 5232
     PDG_anti_Xi_b0 int = -5232
@@ -458,7 +458,7 @@ const (
     PDG_s_tau_plus_1   int =-1000015
 	
     PDG_s_nu_tau_L     int =1000016
-    PDG_s_anti_nu_tau_Lint =-1000016
+    PDG_s_anti_nu_tau_L int =-1000016
 	
     PDG_s_e_minus_R    int =2000011
     PDG_s_e_plus_R     int =-2000011
@@ -479,7 +479,7 @@ const (
     //    PDG_s_anti_chi_0_2 int =-1000023 // Majorana
 	
     PDG_s_chi_plus_1   int =1000024
-    PDG_s_chi_minus_1  int =-1000024 // Majorana
+    PDG_s_chi_minus_1  int =-1000024
 	
     PDG_s_chi_0_3      int =1000025
     //    PDG_s_anti_chi_0_3 int =-1000025 // Majorana
@@ -0,0 +1,327 @@
+package pdg
+
+// table is the particle data table, from the Review of Particle Physics
+// (2022 edition).
+//
+// Each line describes a particle and its antiparticle:
+//  id  mass  width  3*charge  2*spin  name  antiname
+// Masses and widths are in GeV. Widths of long-lived particles are derived
+// from their mean lifetimes. A zero width means the particle is stable or
+// its width has not been measured. An antiname of '-' denotes a
+// self-conjugate particle.
+//
+// The ids are the values of the PDG_xxx constants, which follow an older
+// numbering scheme for some of the excited states (e.g. psi(2S) is 20443).
+// Upsilon(2S) and h_b(3P) use the current ids 100553 and 210553, as the
+// older id of Upsilon(2S), 20553, is the one of chi_b1(1P).
+//
+// Every constant has an entry. Hypothetical particles, unobserved states
+// and generator-specific codes have a zero mass and width; the latter also
+// have a zero spin.
+const table = `
+# quarks
+1       0.00467        0              -1  1  d                  dbar
+2       0.00216        0               2  1  u                  ubar
+3       0.0934         0              -1  1  s                  sbar
+4       1.27           0               2  1  c                  cbar
+5       4.18           0              -1  1  b                  bbar
+6       172.69         1.42            2  1  t                  tbar
+7       0              0              -1  1  l                  lbar
+8       0              0               2  1  h                  hbar
+
+# leptons
+11      0.00051099895  0              -3  1  e-                 e+
+12      0              0               0  1  nu_e               nu_ebar
+13      0.1056583755   2.995984e-19   -3  1  mu-                mu+
+14      0              0               0  1  nu_mu              nu_mubar
+15      1.77686        2.267351e-12   -3  1  tau-               tau+
+16      0              0               0  1  nu_tau             nu_taubar
+17      0              0              -3  1  L-                 L+
+18      0              0               0  1  nu_L               nu_Lbar
+
+# gauge and higgs bosons
+21      0              0               0  2  g                  -
+22      0              0               0  2  gamma              -
+23      91.1876        2.4952          0  2  Z0                 -
+24      80.377         2.085           3  2  W+                 W-
+25      125.25         0.0032          0  0  H0                 -
+
+# hypothetical bosons
+32      0              0               0  2  Z'0                -
+33      0              0               0  2  Z''0               -
+34      0              0               3  2  W'+                W'-
+35      0              0               0  0  H'0                -
+36      0              0               0  0  A0                 -
+37      0              0               3  0  H+                 H-
+40      0              0               0  2  R0                 R0bar
+9900041 0              0               6  0  H_L++              H_L--
+9900042 0              0               6  0  H_R++              H_R--
+
+# generator-specific codes
+28      0              0               0  0  reggeon            -
+29      0              0               0  0  pomeron            -
+81      0              0               0  0  specflav           -
+82      0              0               0  0  rndmflav           rndmflavbar
+83      0              0               0  0  phasespa           -
+84      0              0               0  0  c-hadron           c-hadronbar
+85      0              0               0  0  b-hadron           b-hadronbar
+86      0              0               0  0  t-hadron           t-hadronbar
+89      0              0               3  0  Wvirt+             Wvirt-
+90      0              0               0  0  diquark            diquarkbar
+91      0              0               0  0  cluster            -
+92      0              0               0  0  string             -
+93      0              0               0  0  indep              -
+94      0              0               0  0  CMshower           -
+95      0              0               0  0  SPHEaxis           -
+96      0              0               0  0  THRUaxis           -
+97      0              0               0  0  CLUSjet            -
+98      0              0               0  0  CELLjet            -
+99      0              0               0  0  table              -
+210     0              0               3  0  pi_diffr+          pi_diffr-
+2110    0              0               0  0  n_diffr            n_diffrbar
+2210    0              0               3  0  p_diffr+           p_diffrbar-
+
+# light mesons
+111     0.1349768      7.807971e-09    0  0  pi0                -
+211     0.13957039     2.528375e-17    3  0  pi+                pi-
+221     0.547862       1.31e-06        0  0  eta                -
+331     0.95778        0.000188        0  0  eta'(958)          -
+113     0.77526        0.1491          0  2  rho(770)0          -
+213     0.77526        0.1491          3  2  rho(770)+          rho(770)-
+223     0.78266        0.00868         0  2  omega(782)         -
+333     1.019461       0.004249        0  2  phi(1020)          -
+10111   0.98           0.075           0  0  a_0(980)0          -
+10211   0.98           0.075           3  0  a_0(980)+          a_0(980)-
+10221   0.99           0.055           0  0  f_0(980)           -
+10223   1.166          0.375           0  2  h_1(1170)          -
+10333   1.416          0.09            0  2  h_1(1415)          -
+10113   1.2295         0.142           0  2  b_1(1235)0         -
+10213   1.2295         0.142           3  2  b_1(1235)+         b_1(1235)-
+20113   1.23           0.42            0  2  a_1(1260)0         -
+20213   1.23           0.42            3  2  a_1(1260)+         a_1(1260)-
+20223   1.2819         0.0227          0  2  f_1(1285)          -
+20333   1.4263         0.0545          0  2  f_1(1420)          -
+115     1.3182         0.107           0  4  a_2(1320)0         -
+215     1.3182         0.107           3  4  a_2(1320)+         a_2(1320)-
+225     1.2754         0.1866          0  4  f_2(1270)          -
+335     1.5174         0.086           0  4  f'_2(1525)         -
+20111   1.3            0.4             0  0  pi(1300)0          -
+20211   1.3            0.4             3  0  pi(1300)+          pi(1300)-
+20221   1.294          0.055           0  0  eta(1295)          -
+30113   1.465          0.4             0  2  rho(1450)0         -
+30213   1.465          0.4             3  2  rho(1450)+         rho(1450)-
+40113   1.72           0.25            0  2  rho(1700)0         -
+40213   1.72           0.25            3  2  rho(1700)+         rho(1700)-
+30223   1.41           0.29            0  2  omega(1420)        -
+10331   1.704          0.123           0  0  f_0(1710)          -
+
+# strange mesons
+321     0.493677       5.316736e-17    3  0  K+                 K-
+311     0.497611       0               0  0  K0                 K0bar
+310     0.497611       7.351038e-15    0  0  K_S0               -
+130     0.497611       1.286575e-17    0  0  K_L0               -
+313     0.89555        0.0473          0  2  K*(892)0           K*(892)0bar
+323     0.89167        0.0514          3  2  K*(892)+           K*(892)-
+10311   1.425          0.27            0  0  K*_0(1430)0        K*_0(1430)0bar
+10321   1.425          0.27            3  0  K*_0(1430)+        K*_0(1430)-
+10313   1.253          0.09            0  2  K_1(1270)0         K_1(1270)0bar
+10323   1.253          0.09            3  2  K_1(1270)+         K_1(1270)-
+20313   1.403          0.174           0  2  K_1(1400)0         K_1(1400)0bar
+20323   1.403          0.174           3  2  K_1(1400)+         K_1(1400)-
+315     1.4324         0.109           0  4  K*_2(1430)0        K*_2(1430)0bar
+325     1.4273         0.1             3  4  K*_2(1430)+        K*_2(1430)-
+
+# charmed mesons
+411     1.86966        6.371849e-13    3  0  D+                 D-
+421     1.86484        1.604221e-12    0  0  D0                 D0bar
+413     2.01026        8.34e-05        3  2  D*(2010)+          D*(2010)-
+423     2.00685        0               0  2  D*(2007)0          D*(2007)0bar
+10411   2.343          0.229           3  0  D*_0(2300)+        D*_0(2300)-
+10421   2.343          0.229           0  0  D*_0(2300)0        D*_0(2300)0bar
+10413   2.4232         0.025           3  2  D_1(2420)+         D_1(2420)-
+10423   2.4221         0.0313          0  2  D_1(2420)0         D_1(2420)0bar
+20423   2.412          0.314           0  2  D_1(2430)0         D_1(2430)0bar
+20413   2.412          0.314           3  2  D_1(2430)+         D_1(2430)-
+415     2.4654         0.0467          3  4  D*_2(2460)+        D*_2(2460)-
+425     2.4611         0.0473          0  4  D*_2(2460)0        D*_2(2460)0bar
+431     1.96835        1.305976e-12    3  0  D_s+               D_s-
+433     2.1122         0               3  2  D*_s+              D*_s-
+10431   2.3178         0               3  0  D*_s0(2317)+       D*_s0(2317)-
+10433   2.53511        0.00092         3  2  D_s1(2536)+        D_s1(2536)-
+20433   2.4595         0               3  2  D_s1(2460)+        D_s1(2460)-
+435     2.5691         0.0169          3  4  D*_s2(2573)+       D*_s2(2573)-
+
+# bottom mesons
+511     5.27965        4.333193e-13    0  0  B0                 B0bar
+521     5.27934        4.018388e-13    3  0  B+                 B-
+513     5.32471        0               0  2  B*0                B*0bar
+523     5.32471        0               3  2  B*+                B*-
+10513   5.7261         0.0275          0  2  B_1(5721)0         B_1(5721)0bar
+10523   5.7259         0.031           3  2  B_1(5721)+         B_1(5721)-
+10511   0              0               0  0  B*_00              B*_00bar
+10521   0              0               3  0  B*_0+              B*_0-
+20513   0              0               0  2  B'_10              B'_10bar
+20523   0              0               3  2  B'_1+              B'_1-
+515     5.7395         0.0242          0  4  B*_2(5747)0        B*_2(5747)0bar
+525     5.7372         0.02            3  4  B*_2(5747)+        B*_2(5747)-
+531     5.36688        4.330342e-13    0  0  B_s0               B_s0bar
+533     5.4154         0               0  2  B*_s0              B*_s0bar
+10533   5.8287         0.0005          0  2  B_s1(5830)0        B_s1(5830)0bar
+535     5.83986        0.00149         0  4  B*_s2(5840)0       B*_s2(5840)0bar
+10531   0              0               0  0  B*_s00             B*_s00bar
+20533   0              0               0  2  B'_s10             B'_s10bar
+541     6.27447        1.290612e-12    3  0  B_c+               B_c-
+543     0              0               3  2  B*_c+              B*_c-
+10541   0              0               3  0  B*_c0+             B*_c0-
+10543   0              0               3  2  B_c1+              B_c1-
+20543   0              0               3  2  B'_c1+             B'_c1-
+545     0              0               3  4  B*_c2+             B*_c2-
+
+# charmonium
+441     2.9839         0.032           0  0  eta_c(1S)          -
+20441   3.6375         0.0113          0  0  eta_c(2S)          -
+443     3.0969         9.26e-05        0  2  J/psi(1S)          -
+20443   3.686097       0.000294        0  2  psi(2S)            -
+10441   3.41471        0.0108          0  0  chi_c0(1P)         -
+10443   3.51067        0.00084         0  2  chi_c1(1P)         -
+445     3.55617        0.00197         0  4  chi_c2(1P)         -
+
+# bottomonium
+20551   9.999          0               0  0  eta_b(2S)          -
+553     9.4603         5.402e-05       0  2  Upsilon(1S)        -
+100553  10.02326       3.198e-05       0  2  Upsilon(2S)        -
+60553   10.3552        2.032e-05       0  2  Upsilon(3S)        -
+70553   10.5794        0.0205          0  2  Upsilon(4S)        -
+80553   10.8852        0.037           0  2  Upsilon(10860)     -
+10553   9.8993         0               0  2  h_b(1P)            -
+40553   10.2598        0               0  2  h_b(2P)            -
+551     9.85944        0               0  0  chi_b0(1P)         -
+20553   9.89278        0               0  2  chi_b1(1P)         -
+555     9.91221        0               0  4  chi_b2(1P)         -
+30551   10.2325        0               0  0  chi_b0(2P)         -
+50553   10.25546       0               0  2  chi_b1(2P)         -
+10555   10.26865       0               0  4  chi_b2(2P)         -
+110553  10.5134        0               0  2  chi_b1(3P)         -
+50551   0              0               0  0  chi_b0(3P)         -
+20555   10.5241        0               0  4  chi_b2(3P)         -
+210553  0              0               0  2  h_b(3P)            -
+40551   0              0               0  0  eta_b(3S)          -
+40555   0              0               0  4  eta_b2(1D)         -
+60555   0              0               0  4  eta_b2(2D)         -
+120553  0              0               0  2  Upsilon_1(1D)      -
+557     0              0               0  6  Upsilon_3(1D)      -
+130553  0              0               0  2  Upsilon_1(2D)      -
+50555   0              0               0  4  Upsilon_2(2D)      -
+10557   0              0               0  6  Upsilon_3(2D)      -
+30555   10.1637        0               0  4  Upsilon_2(1D)      -
+
+# light baryons
+2212    0.9382720882   0               3  1  p                  pbar
+2112    0.9395654205   7.493306e-28    0  1  n                  nbar
+1114    1.232          0.117          -3  3  Delta(1232)-       Delta(1232)bar+
+2114    1.232          0.117           0  3  Delta(1232)0       Delta(1232)bar0
+2214    1.232          0.117           3  3  Delta(1232)+       Delta(1232)bar-
+2224    1.232          0.117           6  3  Delta(1232)++      Delta(1232)bar--
+
+# strange baryons
+3122    1.115683       2.500805e-15    0  1  Lambda             Lambdabar
+3222    1.18937        8.209179e-15    3  1  Sigma+             Sigmabar-
+3212    1.192642       8.894756e-06    0  1  Sigma0             Sigmabar0
+3112    1.197449       4.450385e-15   -3  1  Sigma-             Sigmabar+
+3224    1.3828         0.036           3  3  Sigma(1385)+       Sigma(1385)bar-
+3214    1.3837         0.036           0  3  Sigma(1385)0       Sigma(1385)bar0
+3114    1.3872         0.0394         -3  3  Sigma(1385)-       Sigma(1385)bar+
+3322    1.31486        2.269696e-15    0  1  Xi0                Xibar0
+3312    1.32171        4.015936e-15   -3  1  Xi-                Xibar+
+3324    1.5318         0.0091          0  3  Xi(1530)0          Xi(1530)bar0
+3314    1.535          0.0099         -3  3  Xi(1530)-          Xi(1530)bar+
+3334    1.67245        8.017198e-15   -3  3  Omega-             Omegabar+
+
+# charmed baryons
+4122    2.28646        3.266561e-12    3  1  Lambda_c+          Lambda_cbar-
+4222    2.45397        0.00189         6  1  Sigma_c(2455)++    Sigma_c(2455)bar--
+4212    2.4529         0               3  1  Sigma_c(2455)+     Sigma_c(2455)bar-
+4112    2.45375        0.00183         0  1  Sigma_c(2455)0     Sigma_c(2455)bar0
+4224    2.51841        0.01478         6  3  Sigma_c(2520)++    Sigma_c(2520)bar--
+4214    2.5175         0               3  3  Sigma_c(2520)+     Sigma_c(2520)bar-
+4114    2.51848        0.0153          0  3  Sigma_c(2520)0     Sigma_c(2520)bar0
+4322    2.46771        1.453007e-12    3  1  Xi_c+              Xi_cbar-
+4132    2.47044        4.330342e-12    0  1  Xi_c0              Xi_cbar0
+4232    2.5784         0               3  1  Xi'_c+             Xi'_cbar-
+4312    2.579          0               0  1  Xi'_c0             Xi'_cbar0
+4324    2.64557        0.00214         3  3  Xi_c(2645)+        Xi_c(2645)bar-
+4314    2.64638        0.00235         0  3  Xi_c(2645)0        Xi_c(2645)bar0
+4332    2.6952         2.456015e-12    0  1  Omega_c0           Omega_cbar0
+4334    2.7659         0               0  3  Omega_c(2770)0     Omega_c(2770)bar0
+
+# bottom baryons
+5122    5.6196         4.474588e-13    0  1  Lambda_b0          Lambda_bbar0
+5222    5.81056        0.005           3  1  Sigma_b+           Sigma_bbar-
+5112    5.81564        0.00531        -3  1  Sigma_b-           Sigma_bbar+
+5212    0              0               0  1  Sigma_b0           Sigma_bbar0
+5224    5.83032        0.0094          3  3  Sigma*_b+          Sigma*_bbar-
+5114    5.83474        0.0104         -3  3  Sigma*_b-          Sigma*_bbar+
+5214    0              0               0  3  Sigma*_b0          Sigma*_bbar0
+5232    5.7919         4.447378e-13    0  1  Xi_b0              Xi_bbar0
+5132    5.797          4.187099e-13   -3  1  Xi_b-              Xi_bbar+
+5312    5.93502        0              -3  1  Xi'_b-             Xi'_bbar+
+5322    0              0               0  1  Xi'_b0             Xi'_bbar0
+5324    5.952          0.0009          0  3  Xi*_b0             Xi*_bbar0
+5314    5.9553         0.0165         -3  3  Xi*_b-             Xi*_bbar+
+5332    6.0452         4.013488e-13   -3  1  Omega_b-           Omega_bbar+
+5334    0              0              -3  3  Omega*_b-          Omega*_bbar+
+
+# diquarks, with the constituent masses of the Lund string model. The
+# spin-0 diquarks of identical flavours are forbidden and have no mass.
+1101    0              0              -2  0  dd_0               dd_0bar
+1103    0.77133        0              -2  2  dd_1               dd_1bar
+2101    0.57933        0               1  0  ud_0               ud_0bar
+2103    0.77133        0               1  2  ud_1               ud_1bar
+2201    0              0               4  0  uu_0               uu_0bar
+2203    0.77133        0               4  2  uu_1               uu_1bar
+3101    0.80473        0              -2  0  sd_0               sd_0bar
+3103    0.92953        0              -2  2  sd_1               sd_1bar
+3201    0.80473        0               1  0  su_0               su_0bar
+3203    0.92953        0               1  2  su_1               su_1bar
+3301    0              0              -2  0  ss_0               ss_0bar
+3303    1.09361        0              -2  2  ss_1               ss_1bar
+4101    1.96908        0               1  0  cd_0               cd_0bar
+4103    2.00808        0               1  2  cd_1               cd_1bar
+4201    1.96908        0               4  0  cu_0               cu_0bar
+4203    2.00808        0               4  2  cu_1               cu_1bar
+4301    2.15432        0               1  0  cs_0               cs_0bar
+4303    2.17967        0               1  2  cs_1               cs_1bar
+4401    0              0               4  0  cc_0               cc_0bar
+4403    3.27531        0               4  2  cc_1               cc_1bar
+5101    5.38897        0              -2  0  bd_0               bd_0bar
+5103    5.40145        0              -2  2  bd_1               bd_1bar
+5201    5.38897        0               1  0  bu_0               bu_0bar
+5203    5.40145        0               1  2  bu_1               bu_1bar
+5301    5.56725        0              -2  0  bs_0               bs_0bar
+5303    5.57536        0              -2  2  bs_1               bs_1bar
+5401    6.67143        0               1  0  bc_0               bc_0bar
+5403    6.67397        0               1  2  bc_1               bc_1bar
+5501    0              0              -2  0  bb_0               bb_0bar
+5503    10.07354       0              -2  2  bb_1               bb_1bar
+
+# supersymmetric particles
+1000011 0              0              -3  0  ~e_L-              ~e_L+
+1000012 0              0               0  0  ~nu_eL             ~nu_eLbar
+1000013 0              0              -3  0  ~mu_L-             ~mu_L+
+1000014 0              0               0  0  ~nu_muL            ~nu_muLbar
+1000015 0              0              -3  0  ~tau_1-            ~tau_1+
+1000016 0              0               0  0  ~nu_tauL           ~nu_tauLbar
+2000011 0              0              -3  0  ~e_R-              ~e_R+
+2000013 0              0              -3  0  ~mu_R-             ~mu_R+
+2000015 0              0              -3  0  ~tau_2-            ~tau_2+
+1000021 0              0               0  1  ~g                 -
+1000022 0              0               0  1  ~chi_10            -
+1000023 0              0               0  1  ~chi_20            -
+1000024 0              0               3  1  ~chi_1+            ~chi_1-
+1000025 0              0               0  1  ~chi_30            -
+1000035 0              0               0  1  ~chi_40            -
+1000037 0              0               3  1  ~chi_2+            ~chi_2-
+1000039 0              0               0  3  ~Gravitino         -
+`
+
+// EOF
@@ -0,0 +1,208 @@
+package yoda
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// WriteAIDA writes the analysis objects to 'w' in the AIDA XML format.
+// All objects are written as 2-dimensional dataPointSets: Histo1D objects
+// are first converted with NewScatter2DFromHisto1D. The asymmetric errors
+// of each Point2D are mapped onto the errorMinus and errorPlus attributes
+// of the measurements, and annotations onto annotation items.
+func WriteAIDA(w io.Writer, objs ...Object) error {
+	doc := aidaFile{
+		Version: "3.3",
+		Impl:    aidaImpl{Version: "1.1", Package: "go-hep/yoda"},
+		DPS:     make([]aidaDPS, 0, len(objs)),
+	}
+	for _, o := range objs {
+		var s *Scatter2D
+		switch o := o.(type) {
+		case *Histo1D:
+			s = NewScatter2DFromHisto1D(o)
+		case *Scatter2D:
+			s = o
+		default:
+			return fmt.Errorf("yoda: cannot write a %s in AIDA format", o.Type())
+		}
+		dir, name := path.Split(o.Path())
+		if dir != "/" {
+			// keep the root directory of top-level objects
+			dir = strings.TrimSuffix(dir, "/")
+		}
+		dps := aidaDPS{
+			Name:      name,
+			Dimension: 2,
+			Path:      dir,
+			Title:     o.Title(),
+			Items:     aidaItems(o.Annotations()),
+			Points:    make([]aidaPoint, 0, len(s.points)),
+		}
+		for _, p := range s.points {
+			dps.Points = append(dps.Points, aidaPoint{
+				Measurements: []aidaMeas{
+					{Value: p.X(), ErrorMinus: p.XErrMinus(), ErrorPlus: p.XErrPlus()},
+					{Value: p.Y(), ErrorMinus: p.YErrMinus(), ErrorPlus: p.YErrPlus()},
+				},
+			})
+		}
+		doc.DPS = append(doc.DPS, dps)
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(xml.Header)
+	bw.WriteString(`<!DOCTYPE aida SYSTEM "http://aida.freehep.org/schemas/3.3/aida.dtd">` + "\n")
+	enc := xml.NewEncoder(bw)
+	enc.Indent("", "  ")
+	err := enc.Encode(&doc)
+	if err != nil {
+		return err
+	}
+	bw.WriteString("\n")
+	return bw.Flush()
+}
+
+// ReadAIDA reads the 2-dimensional dataPointSets stored in the AIDA XML
+// format from 'r', as Scatter2D objects.
+func ReadAIDA(r io.Reader) ([]Object, error) {
+	dec := xml.NewDecoder(r)
+	dec.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		switch strings.ToLower(charset) {
+		case "iso-8859-1", "latin1":
+			return &latin1Reader{r: bufio.NewReader(input)}, nil
+		}
+		return nil, fmt.Errorf("yoda: unsupported AIDA charset %q", charset)
+	}
+	var doc aidaFile
+	err := dec.Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]Object, 0, len(doc.DPS))
+	for _, dps := range doc.DPS {
+		if dps.Dimension != 2 {
+			return nil, fmt.Errorf("yoda: unsupported AIDA dataPointSet dimension (%d)", dps.Dimension)
+		}
+		s := &Scatter2D{points: make([]*Point2D, 0, len(dps.Points))}
+		for _, p := range dps.Points {
+			if len(p.Measurements) != 2 {
+				return nil, fmt.Errorf("yoda: invalid AIDA dataPoint in %q (%d measurements)",
+					dps.Name, len(p.Measurements))
+			}
+			x, y := p.Measurements[0], p.Measurements[1]
+			s.points = append(s.points,
+				NewPoint2DAsymErr(x.Value, y.Value, x.ErrorMinus, x.ErrorPlus, y.ErrorMinus, y.ErrorPlus))
+		}
+		for _, item := range dps.Items {
+			s.SetAnnotation(item.Key, item.Value)
+		}
+		s.SetPath(path.Join(dps.Path, dps.Name))
+		if dps.Title != "" {
+			s.SetTitle(dps.Title)
+		}
+		objs = append(objs, s)
+	}
+	return objs, nil
+}
+
+type aidaFile struct {
+	XMLName xml.Name  `xml:"aida"`
+	Version string    `xml:"version,attr"`
+	Impl    aidaImpl  `xml:"implementation"`
+	DPS     []aidaDPS `xml:"dataPointSet"`
+}
+
+type aidaImpl struct {
+	Version string `xml:"version,attr"`
+	Package string `xml:"package,attr"`
+}
+
+type aidaDPS struct {
+	Name      string      `xml:"name,attr"`
+	Dimension int         `xml:"dimension,attr"`
+	Path      string      `xml:"path,attr"`
+	Title     string      `xml:"title,attr"`
+	Items     []aidaItem  `xml:"annotation>item"`
+	Points    []aidaPoint `xml:"dataPoint"`
+}
+
+type aidaItem struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type aidaPoint struct {
+	Measurements []aidaMeas `xml:"measurement"`
+}
+
+type aidaMeas struct {
+	Value      float64 `xml:"value,attr"`
+	ErrorPlus  float64 `xml:"errorPlus,attr"`
+	ErrorMinus float64 `xml:"errorMinus,attr"`
+}
+
+// aidaItems converts annotations into AIDA annotation items, sorted by key
+func aidaItems(ann Annotations) []aidaItem {
+	items := make([]aidaItem, 0, len(ann))
+	for k, v := range ann {
+		items = append(items, aidaItem{Key: k, Value: fmt.Sprint(v)})
+	}
+	sort.Sort(sorted_aidaItems(items))
+	return items
+}
+
+// a list of AIDA annotation items sorted by key
+type sorted_aidaItems []aidaItem
+
+func (s sorted_aidaItems) Len() int {
+	return len(s)
+}
+
+func (s sorted_aidaItems) Less(i, j int) bool {
+	return s[i].Key < s[j].Key
+}
+
+func (s sorted_aidaItems) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// latin1Reader converts an ISO-8859-1 stream into UTF-8
+type latin1Reader struct {
+	r   *bufio.Reader
+	buf []byte
+}
+
+func (l *latin1Reader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(l.buf) > 0 {
+			c := copy(p[n:], l.buf)
+			l.buf = l.buf[c:]
+			n += c
+			continue
+		}
+		b, err := l.r.ReadByte()
+		if err != nil {
+			if n > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+		if b < utf8.RuneSelf {
+			p[n] = b
+			n++
+			continue
+		}
+		var enc [utf8.UTFMax]byte
+		sz := utf8.EncodeRune(enc[:], rune(b))
+		l.buf = append(l.buf[:0], enc[:sz]...)
+	}
+	return n, nil
+}
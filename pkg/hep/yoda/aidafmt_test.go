@@ -0,0 +1,113 @@
+package yoda
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestAIDARoundTrip(t *testing.T) {
+	s := NewScatter2D(
+		NewPoint2DAsymErr(1, 10, 0.5, 0.25, 1, 2),
+		NewPoint2DAsymErr(2, 1.0/3, 0.25, 0.5, 0.1, 1e-300),
+	)
+	s.SetPath("/ANALYSIS/d01-x01-y01")
+	s.SetTitle("p_T < 10 & y > 0")
+	s.SetAnnotation("XLabel", `$p_\perp$ [GeV]`)
+
+	h, err := NewHisto1D(2, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.SetPath("/h")
+	h.Fill(0.5, 4)
+	h.Fill(1.5, 9)
+
+	var buf bytes.Buffer
+	err = WriteAIDA(&buf, s, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs, err := ReadAIDA(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 2 {
+		t.Fatalf("got %d objects, want 2", len(objs))
+	}
+
+	o := objs[0].(*Scatter2D)
+	if o.Path() != s.Path() || o.Title() != s.Title() {
+		t.Errorf("got path %q and title %q, want %q and %q", o.Path(), o.Title(), s.Path(), s.Title())
+	}
+	if v, _ := o.AnnotationString("XLabel"); v != `$p_\perp$ [GeV]` {
+		t.Errorf("got XLabel %q", v)
+	}
+	// the asymmetric errors are mapped onto errorMinus and errorPlus
+	for i, p := range s.points {
+		if *o.points[i] != *p {
+			t.Errorf("point %d: got %+v, want %+v", i, *o.points[i], *p)
+		}
+	}
+
+	// histograms are written as their scatter
+	hs := objs[1].(*Scatter2D)
+	if hs.Path() != "/h" {
+		t.Errorf("histogram: got path %q, want /h", hs.Path())
+	}
+	for i, p := range NewScatter2DFromHisto1D(h).points {
+		if *hs.points[i] != *p {
+			t.Errorf("histogram point %d: got %+v, want %+v", i, *hs.points[i], *p)
+		}
+	}
+}
+
+func TestReadAIDALatin1(t *testing.T) {
+	src := "<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n" +
+		"<aida version=\"3.3\">\n" +
+		"<dataPointSet name=\"d01\" dimension=\"2\" path=\"/REF\" title=\"caf\xe9\">\n" +
+		"<dataPoint><measurement value=\"1\" errorPlus=\"0.5\" errorMinus=\"0.5\"/>" +
+		"<measurement value=\"3\" errorPlus=\"1\" errorMinus=\"2\"/></dataPoint>\n" +
+		"</dataPointSet>\n</aida>\n"
+	objs, err := ReadAIDA(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 1 {
+		t.Fatalf("got %d objects, want 1", len(objs))
+	}
+	s := objs[0].(*Scatter2D)
+	if s.Path() != "/REF/d01" || s.Title() != "café" {
+		t.Errorf("got path %q and title %q", s.Path(), s.Title())
+	}
+	p := s.Point(0)
+	if p.Y() != 3 || p.YErrMinus() != 2 || p.YErrPlus() != 1 {
+		t.Errorf("got point %+v", *p)
+	}
+}
+
+func TestAIDAErrors(t *testing.T) {
+	p, err := NewProfile1D(1, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteAIDA(&buf, p); err == nil {
+		t.Errorf("writing a profile: no error")
+	}
+
+	const point = `<dataPoint><measurement value="1"/><measurement value="2"/></dataPoint>`
+	for _, c := range []struct {
+		name string
+		src  string
+	}{
+		{"charset", `<?xml version="1.0" encoding="EBCDIC"?><aida/>`},
+		{"dimension", `<aida><dataPointSet name="d" dimension="3">` + point + `</dataPointSet></aida>`},
+		{"measurements", `<aida><dataPointSet name="d" dimension="2"><dataPoint><measurement value="1"/></dataPoint></dataPointSet></aida>`},
+		{"xml", `<aida><dataPointSet`},
+	} {
+		if _, err := ReadAIDA(strings.NewReader(c.src)); err == nil {
+			t.Errorf("%s: no error", c.name)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package yoda
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// BinningRule selects how an AutoHisto1D chooses its bin width
+type BinningRule int
+
+const (
+	// FreedmanDiaconis uses bins of width 2*IQR/n^(1/3), where IQR is the
+	// interquartile range of the n buffered values. It is robust against
+	// outliers.
+	FreedmanDiaconis BinningRule = iota
+	// Scott uses bins of width 3.49*sigma/n^(1/3), where sigma is the
+	// standard deviation of the buffered values
+	Scott
+	// Sturges uses 1+log2(n) bins. It is only suited to normally
+	// distributed values.
+	Sturges
+)
+
+func (r BinningRule) String() string {
+	switch r {
+	case FreedmanDiaconis:
+		return "FreedmanDiaconis"
+	case Scott:
+		return "Scott"
+	case Sturges:
+		return "Sturges"
+	}
+	return fmt.Sprintf("BinningRule(%d)", int(r))
+}
+
+// maximum number of bins created by an AutoHisto1D
+const maxAutoBins = 10000
+
+// a buffered fill
+type autoFill struct {
+	x, w float64
+}
+
+// AutoHisto1D is a Histo1D whose binning is chosen from its first fills.
+//
+// The first fills are buffered. Once the buffer is full (or when Flush or
+// Histo is called), the range of the histogram is set to the range of the
+// buffered values, the bin width is chosen with the BinningRule, and the
+// buffered fills are replayed. The following fills go directly to the
+// histogram:
+//
+//  h := yoda.NewAutoHisto1D(1000, yoda.FreedmanDiaconis)
+//  for t.Next() {
+//      h.Fill(pt, w)
+//  }
+//  histo, err := h.Histo()
+//
+// The bin width is computed from the (unweighted) buffered x-values; NaN
+// and infinite values are ignored. The bins are equally spaced and the last
+// buffered value falls inside the last bin.
+// If the binning cannot be chosen when the buffer is full, the fills keep
+// being buffered and the binning is tried again each time the buffer
+// doubles. The error of the last try is returned by Err.
+type AutoHisto1D struct {
+	rule  BinningRule
+	nbuf  int
+	buf   []autoFill
+	h     *Histo1D
+	retry int   // number of buffered fills triggering the next flush
+	err   error // error of the last flush
+}
+
+// Create a new AutoHisto1D buffering the first 'nbuf' fills and binned with
+// the rule 'rule'
+func NewAutoHisto1D(nbuf int, rule BinningRule) *AutoHisto1D {
+	if nbuf < 1 {
+		nbuf = 1
+	}
+	return &AutoHisto1D{
+		rule:  rule,
+		nbuf:  nbuf,
+		buf:   make([]autoFill, 0, nbuf),
+		retry: nbuf,
+	}
+}
+
+// Fill the histogram with weight 'weight' at position 'x'.
+// The fill is buffered until the binning is chosen.
+func (a *AutoHisto1D) Fill(x, weight float64) {
+	if a.h != nil {
+		a.h.Fill(x, weight)
+		return
+	}
+	a.buf = append(a.buf, autoFill{x, weight})
+	if len(a.buf) >= a.retry {
+		// on failure, the fills keep being buffered: the error is
+		// recorded by Flush
+		a.Flush()
+	}
+}
+
+// Returns the number of buffered fills
+func (a *AutoHisto1D) Buffered() int {
+	return len(a.buf)
+}
+
+// Returns the error of the last attempt to choose the binning, nil if it
+// succeeded or was not attempted yet
+func (a *AutoHisto1D) Err() error {
+	return a.err
+}
+
+// Flush chooses the binning from the buffered fills, creates the histogram
+// and replays the fills into it.
+// ErrLowStats is returned if no finite value was buffered.
+// On failure, the fills stay buffered and the next automatic flush is
+// delayed until the buffer doubles.
+// Flush does nothing once the histogram has been created.
+func (a *AutoHisto1D) Flush() error {
+	if a.h != nil {
+		return nil
+	}
+	a.err = a.flush()
+	if a.err != nil && 2*len(a.buf) > a.nbuf {
+		a.retry = 2 * len(a.buf)
+	}
+	return a.err
+}
+
+// flush chooses the binning and replays the buffered fills
+func (a *AutoHisto1D) flush() error {
+	xs := make([]float64, 0, len(a.buf))
+	for _, f := range a.buf {
+		if !math.IsNaN(f.x) && !math.IsInf(f.x, 0) {
+			xs = append(xs, f.x)
+		}
+	}
+	if len(xs) == 0 {
+		return fmt.Errorf("yoda: no finite value to choose the binning from: %w", ErrLowStats)
+	}
+	sort.Float64s(xs)
+	nbins, lower, upper := autoBinning(xs, a.rule)
+	h, err := NewHisto1D(nbins, lower, upper)
+	if err != nil {
+		return err
+	}
+	for _, f := range a.buf {
+		h.Fill(f.x, f.w)
+	}
+	a.h = h
+	a.buf = nil
+	return nil
+}
+
+// Returns the histogram, flushing the buffered fills first
+func (a *AutoHisto1D) Histo() (*Histo1D, error) {
+	err := a.Flush()
+	if err != nil {
+		return nil, err
+	}
+	return a.h, nil
+}
+
+// autoBinning returns the number of bins and the range of an axis holding
+// the sorted values 'xs', with a bin width chosen with 'rule'
+func autoBinning(xs []float64, rule BinningRule) (int, float64, float64) {
+	n := float64(len(xs))
+	lo := xs[0]
+	hi := xs[len(xs)-1]
+	if !(hi > lo) {
+		// a single value: a unit bin around it
+		width := math.Max(math.Abs(lo)*1e-3, 1)
+		return 1, lo - width/2, lo + width/2
+	}
+	width := 0.0
+	switch rule {
+	case FreedmanDiaconis:
+		iqr := quantile(xs, 0.75) - quantile(xs, 0.25)
+		width = 2 * iqr / math.Cbrt(n)
+	case Scott:
+		width = 3.49 * stdDev(xs) / math.Cbrt(n)
+	}
+	var nbins int
+	if width > 0 {
+		nbins = int(math.Ceil((hi - lo) / width))
+	} else {
+		// Sturges, or a vanishing spread of the values
+		nbins = int(math.Ceil(math.Log2(n))) + 1
+		width = (hi - lo) / float64(nbins)
+	}
+	if nbins > maxAutoBins {
+		nbins = maxAutoBins
+		width = (hi - lo) / float64(nbins)
+	}
+	if nbins < 1 {
+		nbins = 1
+	}
+	upper := lo + float64(nbins)*width
+	if !(upper > hi) {
+		// the last value must fall inside the last bin: the range is
+		// widened by the smallest amount, rather than by an extra bin
+		upper = math.Nextafter(hi, math.Inf(+1))
+	}
+	return nbins, lo, upper
+}
+
+// Returns the (unweighted) standard deviation of the values
+func stdDev(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	m := 0.0
+	for _, x := range xs {
+		m += x
+	}
+	m /= float64(len(xs))
+	v := 0.0
+	for _, x := range xs {
+		v += (x - m) * (x - m)
+	}
+	return math.Sqrt(v / float64(len(xs)-1))
+}
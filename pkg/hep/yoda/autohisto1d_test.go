@@ -0,0 +1,118 @@
+package yoda
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAutoHisto1DFlushError(t *testing.T) {
+	const nbuf = 4
+	a := NewAutoHisto1D(nbuf, Scott)
+	for i := 0; i < nbuf; i++ {
+		a.Fill(math.NaN(), 1)
+	}
+	if !errors.Is(a.Err(), ErrLowStats) {
+		t.Fatalf("full buffer of NaNs: got error %v, want %v", a.Err(), ErrLowStats)
+	}
+	if a.Buffered() != nbuf {
+		t.Fatalf("full buffer of NaNs: %d buffered fills, want %d", a.Buffered(), nbuf)
+	}
+
+	// the binning is not tried again before the buffer doubles
+	for i := nbuf; i < 2*nbuf-1; i++ {
+		a.Fill(float64(i), 1)
+		if a.Buffered() != i+1 {
+			t.Fatalf("fill %d: %d buffered fills, want %d", i, a.Buffered(), i+1)
+		}
+		if !errors.Is(a.Err(), ErrLowStats) {
+			t.Fatalf("fill %d: got error %v, want %v", i, a.Err(), ErrLowStats)
+		}
+	}
+	a.Fill(float64(2*nbuf-1), 1)
+	if a.Buffered() != 0 {
+		t.Fatalf("doubled buffer: %d buffered fills, want 0", a.Buffered())
+	}
+	if a.Err() != nil {
+		t.Fatalf("doubled buffer: got error %v", a.Err())
+	}
+	h, err := a.Histo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, want := h.NumEntries(), uint64(2*nbuf); n != want {
+		t.Errorf("got %d entries, want %d", n, want)
+	}
+}
+
+func TestAutoHisto1DHistoError(t *testing.T) {
+	a := NewAutoHisto1D(10, FreedmanDiaconis)
+	a.Fill(math.Inf(+1), 1)
+	_, err := a.Histo()
+	if !errors.Is(err, ErrLowStats) {
+		t.Fatalf("got error %v, want %v", err, ErrLowStats)
+	}
+	if a.Err() != err {
+		t.Errorf("Err: got %v, want %v", a.Err(), err)
+	}
+
+	// an explicit flush is always tried
+	a.Fill(1, 1)
+	h, err := a.Histo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Err() != nil {
+		t.Errorf("Err after a successful flush: %v", a.Err())
+	}
+	if h.NumBins() != 1 {
+		t.Errorf("got %d bins, want 1", h.NumBins())
+	}
+}
+
+func TestAutoHisto1DBinning(t *testing.T) {
+	sample := func(n int) []float64 {
+		xs := make([]float64, n)
+		for i := range xs {
+			xs[i] = float64(i)
+		}
+		return xs
+	}
+	for _, c := range []struct {
+		rule  BinningRule
+		n     int
+		nbins uint64
+	}{
+		// ceil(log2(n))+1 bins
+		{Sturges, 100, 8},
+		{Sturges, 64, 7},
+		// widths 3.49*sigma/n^(1/3) = 21.70 and 2*IQR/n^(1/3) = 21.33 for
+		// a range of 99
+		{Scott, 100, 5},
+		{FreedmanDiaconis, 100, 5},
+		// widths 16.12 and 15.75 for a range of 63: the range is exactly 4
+		// Freedman-Diaconis bins
+		{Scott, 64, 4},
+		{FreedmanDiaconis, 64, 4},
+	} {
+		xs := sample(c.n)
+		a := NewAutoHisto1D(c.n, c.rule)
+		for _, x := range xs {
+			a.Fill(x, 1)
+		}
+		h, err := a.Histo()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.NumBins() != c.nbins {
+			t.Errorf("%v, %d values: got %d bins, want %d", c.rule, c.n, h.NumBins(), c.nbins)
+		}
+		// the range is the one of the values, the last one included
+		if h.LowEdge() != 0 || !(h.HighEdge() > xs[c.n-1]) {
+			t.Errorf("%v, %d values: got range [%v, %v]", c.rule, c.n, h.LowEdge(), h.HighEdge())
+		}
+		if h.Integral(false) != float64(c.n) {
+			t.Errorf("%v, %d values: got %v values in the bins, want %d", c.rule, c.n, h.Integral(false), c.n)
+		}
+	}
+}
@@ -1,6 +1,19 @@
 package yoda
 
-import "sort"
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// UnderflowIndex is the bin index returned for coordinates below the
+	// low edge of an axis
+	UnderflowIndex = -1
+	// OverflowIndex is the bin index returned for coordinates at or above
+	// the high edge of an axis (and for NaN coordinates)
+	OverflowIndex = -2
+)
 
 // Axis1D is a container of ordered bins
 type Axis1D struct {
@@ -17,35 +30,92 @@ type Axis1D struct {
 	// bin edges: lower edges, except last entry, which is the high edge of the last entry
 	edges []float64
 
-	// hash table for fast bin lookup
-	hash map[float64]int
+	// whether the edges are equally spaced, enabling O(1) bin lookup
+	uniform bool
 }
 
-// Create a new Axis1D from a list of bin edges
-func NewAxis1DFromEdges(edges []float64) *Axis1D {
+// Create a new Axis1D from a list of bin edges.
+// The edges must be strictly increasing.
+func NewAxis1DFromEdges(edges []float64) (*Axis1D, error) {
+	err := checkEdges(edges)
+	if err != nil {
+		return nil, err
+	}
 	nbins := len(edges) - 1
 	a := &Axis1D{bins: make([]hbin1d, 0, nbins),
 		underflow: dbn1d{},
 		overflow:  dbn1d{},
 		dbn:       dbn1d{},
-		edges:     make([]float64, 0, len(edges)),
-		hash:      make(map[float64]int),
+		edges:     make([]float64, len(edges)),
 	}
 	copy(a.edges, edges)
 	for i := 0; i < nbins; i++ {
 		a.bins = append(a.bins, hbin1d{*NewBin1D(edges[i], edges[i+1])})
 	}
 	sort.Sort((sorted_hbin1ds)(a.bins))
-	for i := range a.bins {
-		// insert upper bound mapped to bin id
-		a.hash[edges[i+1]] = i
-	}
-	return a
+	return a, nil
 }
 
 // Create a new Axis1D from a number of bins and a bin distribution
-func NewAxis1D(nbins int, lower, upper float64) *Axis1D {
-	return NewAxis1DFromEdges(linspace(lower, upper, nbins))
+func NewAxis1D(nbins int, lower, upper float64) (*Axis1D, error) {
+	edges, err := linspace(lower, upper, nbins)
+	if err != nil {
+		return nil, err
+	}
+	a, err := NewAxis1DFromEdges(edges)
+	if err != nil {
+		return nil, err
+	}
+	a.uniform = true
+	return a, nil
+}
+
+// Create a new Axis1D with 'nbins' bins between 'lower' and 'upper',
+// equally spaced in log(x). 'lower' must be strictly positive.
+func NewAxis1DLog(nbins int, lower, upper float64) (*Axis1D, error) {
+	edges, err := logspace(lower, upper, nbins)
+	if err != nil {
+		return nil, err
+	}
+	return NewAxis1DFromEdges(edges)
+}
+
+// Create a new Axis1D with 'nbins' bins between 'lower' and 'upper',
+// equally spaced in x^(1/power). A power greater than 1 gives bins
+// widening with x (e.g. power=2 for bins equally spaced in sqrt(x)).
+// 'lower' must be positive.
+func NewAxis1DPow(nbins int, lower, upper, power float64) (*Axis1D, error) {
+	edges, err := pspace(lower, upper, nbins, power)
+	if err != nil {
+		return nil, err
+	}
+	return NewAxis1DFromEdges(edges)
+}
+
+// Create a new Axis1D with (at most) 'nbins' bins holding the same number
+// of values of 'sample'.
+// The axis spans the whole sample; fewer bins are created when repeated
+// values make some quantiles coincide.
+func NewAxis1DFromQuantiles(sample []float64, nbins int) (*Axis1D, error) {
+	edges, err := quantileEdges(sample, nbins)
+	if err != nil {
+		return nil, err
+	}
+	return NewAxis1DFromEdges(edges)
+}
+
+// checkEdges checks a list of edges defines at least one bin and is
+// strictly increasing
+func checkEdges(edges []float64) error {
+	if len(edges) < 2 {
+		return fmt.Errorf("need at least 2 edges (got %d): %w", len(edges), ErrInvalidRange)
+	}
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i] > edges[i-1]) {
+			return fmt.Errorf("edges are not strictly increasing at index %d: %w", i, ErrInvalidRange)
+		}
+	}
+	return nil
 }
 
 // Returns the number of bins (not counting under|over-flows)
@@ -70,7 +140,7 @@ func (a *Axis1D) LowEdge() float64 {
 
 // Returns the high edge of the axis
 func (a *Axis1D) HighEdge() float64 {
-	return a.bins[len(a.bins)-1].LowEdge()
+	return a.bins[len(a.bins)-1].HighEdge()
 }
 
 // Returns the bin at bin number 'id'
@@ -78,21 +148,75 @@ func (a *Axis1D) Bin(id int) *hbin1d {
 	return &a.bins[id]
 }
 
-// Returns the bin at coordinate 'x'
+// Returns the index of the bin containing coordinate 'x', or
+// UnderflowIndex (resp. OverflowIndex) if 'x' is below (resp. at or above)
+// the axis range.
+func (a *Axis1D) BinIndex(x float64) int {
+	return findBin(a.edges, a.uniform, x)
+}
+
+// Returns the bin at coordinate 'x', or nil if 'x' is out of range
 func (a *Axis1D) BinByCoord(x float64) *hbin1d {
-	id, ok := a.hash[x]
-	if ok {
-		return &a.bins[id]
-	}
-	//FIXME: O(N) search
-	for i := range a.edges[1:] {
-		if a.edges[i-1] <= x && a.edges[i] < x {
-			id = i - 1
-			a.hash[x] = id
-			return &a.bins[id]
+	id := a.BinIndex(x)
+	if id < 0 {
+		return nil
+	}
+	return &a.bins[id]
+}
+
+// findBin returns the index of the bin [edges[i], edges[i+1]) containing
+// 'x', or one of the UnderflowIndex and OverflowIndex sentinels.
+// If 'uniform' is true, the edges are assumed to be equally spaced and the
+// index is computed arithmetically, then corrected for rounding.
+// Otherwise a binary search over the edges is performed.
+func findBin(edges []float64, uniform bool, x float64) int {
+	n := len(edges) - 1
+	switch {
+	case math.IsNaN(x):
+		return OverflowIndex
+	case x < edges[0]:
+		return UnderflowIndex
+	case x >= edges[n]:
+		return OverflowIndex
+	}
+	if uniform {
+		i := int((x - edges[0]) / (edges[n] - edges[0]) * float64(n))
+		if i >= n {
+			i = n - 1
+		}
+		// the edges are not exactly equally spaced in floating point
+		for i > 0 && x < edges[i] {
+			i--
 		}
+		for i < n-1 && x >= edges[i+1] {
+			i++
+		}
+		return i
 	}
-	return nil
+	// the first edge strictly greater than x is the high edge of the bin
+	return sort.Search(len(edges), func(i int) bool { return edges[i] > x }) - 1
+}
+
+// Fill the axis with weight 'weight' at position 'x'.
+// Fills outside the axis range are routed to the underflow or overflow
+// distributions. All fills contribute to the total distribution.
+func (a *Axis1D) fill(x, weight float64) {
+	a.dbn.fill(x, weight)
+	switch id := a.BinIndex(x); id {
+	case UnderflowIndex:
+		a.underflow.fill(x, weight)
+	case OverflowIndex:
+		a.overflow.fill(x, weight)
+	default:
+		a.bins[id].fill(x, weight)
+	}
+}
+
+// Fill the bin number 'id' with weight 'weight' at the bin midpoint
+func (a *Axis1D) fillBin(id int, weight float64) {
+	bin := &a.bins[id]
+	a.dbn.fill(bin.MidPoint(), weight)
+	bin.fillBin(weight)
 }
 
 // Reset the axis content
@@ -115,17 +239,163 @@ func (a *Axis1D) ScaleW(scale float64) {
 	}
 }
 
-// In-place add of 2 axes
-func Axis1D_IAdd(a, b *Axis1D) error {
-	//FIXME error handling
+// checkAxis1DBins returns an ErrBinningMismatch error unless the axes 'a'
+// and 'b' have bins with identical edges, so that the operation 'op' can
+// combine them bin by bin without failing half-way
+func checkAxis1DBins(op string, a, b *Axis1D) error {
 	if len(a.bins) != len(b.bins) {
-		panic("axes lengthes differ")
+		return fmt.Errorf("%s: axes' number of bins differ (%d != %d): %w",
+			op, len(a.bins), len(b.bins), ErrBinningMismatch)
 	}
 	for i := range a.bins {
-		err := hbin1d_iadd(&a.bins[i], &b.bins[i])
-		if err != nil {
-			return err
+		if a.bins[i].edges != b.bins[i].edges {
+			return fmt.Errorf("%s: axes' edges of bin %d differ (%v != %v): %w",
+				op, i, a.bins[i].edges, b.bins[i].edges, ErrBinningMismatch)
 		}
 	}
+	return nil
+}
+
+// In-place add of 2 axes.
+// The axes are left unchanged if their binnings differ.
+func Axis1D_IAdd(a, b *Axis1D) error {
+	err := checkAxis1DBins("iadd", a, b)
+	if err != nil {
+		return err
+	}
+	for i := range a.bins {
+		dbn1d_iadd(&a.bins[i].xdbn, &b.bins[i].xdbn)
+	}
+	dbn1d_iadd(&a.underflow, &b.underflow)
+	dbn1d_iadd(&a.overflow, &b.overflow)
 	return dbn1d_iadd(&a.dbn, &b.dbn)
 }
+
+// In-place subtraction of 2 axes.
+// The axes are left unchanged if their binnings differ.
+func Axis1D_ISub(a, b *Axis1D) error {
+	err := checkAxis1DBins("isub", a, b)
+	if err != nil {
+		return err
+	}
+	for i := range a.bins {
+		dbn1d_isub(&a.bins[i].xdbn, &b.bins[i].xdbn)
+	}
+	dbn1d_isub(&a.underflow, &b.underflow)
+	dbn1d_isub(&a.overflow, &b.overflow)
+	return dbn1d_isub(&a.dbn, &b.dbn)
+}
+
+// Returns whether 2 axes have the same binning, comparing their edges
+// with a degree of fuzziness
+func Axis1D_SameBinning(a, b *Axis1D) bool {
+	if len(a.edges) != len(b.edges) {
+		return false
+	}
+	for i := range a.edges {
+		if !fFuzzyEq(a.edges[i], b.edges[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns a deep copy of the axis
+func (a *Axis1D) Clone() *Axis1D {
+	o := *a
+	o.bins = make([]hbin1d, len(a.bins))
+	copy(o.bins, a.bins)
+	o.edges = make([]float64, len(a.edges))
+	copy(o.edges, a.edges)
+	return &o
+}
+
+// Merge groups of 'n' consecutive bins.
+// If the number of bins is not a multiple of 'n', the last bin holds the
+// remaining bins, so that the range of the axis is preserved.
+func (a *Axis1D) Rebin(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("rebin: invalid group size %d: %w", n, ErrInvalidRange)
+	}
+	nbins := len(a.bins)
+	edges := make([]float64, 0, nbins/n+2)
+	for i := 0; i < nbins; i += n {
+		edges = append(edges, a.edges[i])
+	}
+	edges = append(edges, a.edges[nbins])
+	uniform := a.uniform && nbins%n == 0
+	err := a.RebinTo(edges)
+	if err != nil {
+		return err
+	}
+	a.uniform = uniform
+	return nil
+}
+
+// Merge the bins 'from' to 'to' (inclusive) into a single bin
+func (a *Axis1D) MergeBins(from, to int) error {
+	if from < 0 || to >= len(a.bins) || from > to {
+		return fmt.Errorf("merge bins [%d, %d] of a %d-bins axis: %w",
+			from, to, len(a.bins), ErrIndexOutOfRange)
+	}
+	edges := make([]float64, 0, len(a.edges)-(to-from))
+	edges = append(edges, a.edges[:from+1]...)
+	edges = append(edges, a.edges[to+1:]...)
+	return a.RebinTo(edges)
+}
+
+// Change the binning of the axis to the given edges.
+// Each new edge must coincide with a distinct existing one. If the new edges span
+// a narrower range, the content of the bins left out is moved to the
+// underflow or overflow distributions. The total distribution is unchanged.
+func (a *Axis1D) RebinTo(edges []float64) error {
+	err := checkEdges(edges)
+	if err != nil {
+		return err
+	}
+	// tolerance on the matching of edges close to 0
+	eps := 1e-10 * (a.edges[len(a.edges)-1] - a.edges[0])
+	idx := make([]int, len(edges))
+	j := 0
+	for i, e := range edges {
+		match := func(v float64) bool {
+			return fFuzzyEq(v, e) || math.Abs(v-e) < eps
+		}
+		for j < len(a.edges) && a.edges[j] < e && !match(a.edges[j]) {
+			j++
+		}
+		if j == len(a.edges) || !match(a.edges[j]) {
+			return fmt.Errorf("rebin: edge %v does not coincide with an existing edge: %w",
+				e, ErrBinningMismatch)
+		}
+		if i > 0 && j == idx[i-1] {
+			return fmt.Errorf("rebin: edges %v and %v coincide with the same existing edge: %w",
+				edges[i-1], e, ErrBinningMismatch)
+		}
+		idx[i] = j
+	}
+
+	bins := make([]hbin1d, 0, len(idx)-1)
+	for i := 0; i+1 < len(idx); i++ {
+		bin := hbin1d{*NewBin1D(a.edges[idx[i]], a.edges[idx[i+1]])}
+		for k := idx[i]; k < idx[i+1]; k++ {
+			dbn1d_iadd(&bin.xdbn, &a.bins[k].xdbn)
+		}
+		bins = append(bins, bin)
+	}
+	for k := 0; k < idx[0]; k++ {
+		dbn1d_iadd(&a.underflow, &a.bins[k].xdbn)
+	}
+	for k := idx[len(idx)-1]; k < len(a.bins); k++ {
+		dbn1d_iadd(&a.overflow, &a.bins[k].xdbn)
+	}
+
+	newedges := make([]float64, len(idx))
+	for i, k := range idx {
+		newedges[i] = a.edges[k]
+	}
+	a.bins = bins
+	a.edges = newedges
+	a.uniform = false
+	return nil
+}
@@ -0,0 +1,275 @@
+package yoda
+
+import "fmt"
+
+// Axis2D is a container of bins ordered on a 2D grid.
+// Fills outside of the grid are recorded in 8 outflow distributions, one
+// per region around the grid.
+type Axis2D struct {
+	// the bins contained in this histogram, x-index running fastest
+	bins []Bin2D
+
+	// distribution counters for the 8 regions around the grid
+	outflows [8]dbn2d
+
+	// a distribution counter for the whole histogram
+	dbn dbn2d
+
+	// bin edges along x and y
+	xedges []float64
+	yedges []float64
+
+	// whether the edges are equally spaced, enabling O(1) bin lookup
+	xuniform bool
+	yuniform bool
+}
+
+// Create a new Axis2D from lists of bin edges along x and y.
+// The edges must be strictly increasing.
+func NewAxis2DFromEdges(xedges, yedges []float64) (*Axis2D, error) {
+	for _, edges := range [][]float64{xedges, yedges} {
+		err := checkEdges(edges)
+		if err != nil {
+			return nil, err
+		}
+	}
+	nx := len(xedges) - 1
+	ny := len(yedges) - 1
+	a := &Axis2D{
+		bins:   make([]Bin2D, 0, nx*ny),
+		xedges: make([]float64, len(xedges)),
+		yedges: make([]float64, len(yedges)),
+	}
+	copy(a.xedges, xedges)
+	copy(a.yedges, yedges)
+	for iy := 0; iy < ny; iy++ {
+		for ix := 0; ix < nx; ix++ {
+			a.bins = append(a.bins,
+				*NewBin2D(xedges[ix], xedges[ix+1], yedges[iy], yedges[iy+1]))
+		}
+	}
+	return a, nil
+}
+
+// Create a new Axis2D with 'nx' (resp. 'ny') bins equally spaced between
+// 'xlow' and 'xhigh' (resp. 'ylow' and 'yhigh')
+func NewAxis2D(nx int, xlow, xhigh float64, ny int, ylow, yhigh float64) (*Axis2D, error) {
+	xedges, err := linspace(xlow, xhigh, nx)
+	if err != nil {
+		return nil, err
+	}
+	yedges, err := linspace(ylow, yhigh, ny)
+	if err != nil {
+		return nil, err
+	}
+	a, err := NewAxis2DFromEdges(xedges, yedges)
+	if err != nil {
+		return nil, err
+	}
+	a.xuniform = true
+	a.yuniform = true
+	return a, nil
+}
+
+// Returns the number of bins (not counting outflows)
+func (a *Axis2D) NumBins() uint64 {
+	return uint64(len(a.bins))
+}
+
+// Returns the number of bins along x
+func (a *Axis2D) NumBinsX() uint64 {
+	return uint64(len(a.xedges) - 1)
+}
+
+// Returns the number of bins along y
+func (a *Axis2D) NumBinsY() uint64 {
+	return uint64(len(a.yedges) - 1)
+}
+
+// Returns the bins of the axis, x-index running fastest
+func (a *Axis2D) Bins() []Bin2D {
+	return a.bins
+}
+
+// Returns the bin at bin numbers ('ix', 'iy')
+func (a *Axis2D) Bin(ix, iy int) *Bin2D {
+	return &a.bins[iy*(len(a.xedges)-1)+ix]
+}
+
+// Returns the low edge of the axis along x
+func (a *Axis2D) XLowEdge() float64 {
+	return a.xedges[0]
+}
+
+// Returns the high edge of the axis along x
+func (a *Axis2D) XHighEdge() float64 {
+	return a.xedges[len(a.xedges)-1]
+}
+
+// Returns the low edge of the axis along y
+func (a *Axis2D) YLowEdge() float64 {
+	return a.yedges[0]
+}
+
+// Returns the high edge of the axis along y
+func (a *Axis2D) YHighEdge() float64 {
+	return a.yedges[len(a.yedges)-1]
+}
+
+// Returns the indices of the bin containing ('x', 'y').
+// Each index is UnderflowIndex (resp. OverflowIndex) if the corresponding
+// coordinate is below (resp. at or above) the axis range.
+func (a *Axis2D) BinIndex(x, y float64) (int, int) {
+	return findBin(a.xedges, a.xuniform, x), findBin(a.yedges, a.yuniform, y)
+}
+
+// Returns the bin at coordinate ('x', 'y'), or nil if it is out of range
+func (a *Axis2D) BinByCoord(x, y float64) *Bin2D {
+	ix, iy := a.BinIndex(x, y)
+	if ix < 0 || iy < 0 {
+		return nil
+	}
+	return a.Bin(ix, iy)
+}
+
+// Returns the outflow distribution for the region ('ix', 'iy'), where
+// -1 means below the range, 0 within and +1 above, along each axis.
+// (0, 0) is the grid itself and has no outflow distribution.
+func (a *Axis2D) Outflow(ix, iy int) (*dbn2d, error) {
+	if ix < -1 || ix > 1 || iy < -1 || iy > 1 {
+		return nil, fmt.Errorf("outflow: region (%d, %d) not in [-1, 1]: %w", ix, iy, ErrIndexOutOfRange)
+	}
+	if ix == 0 && iy == 0 {
+		return nil, fmt.Errorf("outflow: (0, 0) is not an outflow region: %w", ErrIndexOutOfRange)
+	}
+	return &a.outflows[outflowIndex(ix, iy)], nil
+}
+
+// outflowIndex maps an outflow region to its index in [0, 8)
+func outflowIndex(ix, iy int) int {
+	i := (iy+1)*3 + (ix + 1)
+	if i > 4 {
+		// skip the (0, 0) grid region
+		i--
+	}
+	return i
+}
+
+// outflowRegion maps a bin index to -1, 0 or +1
+func outflowRegion(id int) int {
+	switch id {
+	case UnderflowIndex:
+		return -1
+	case OverflowIndex:
+		return +1
+	}
+	return 0
+}
+
+// Fill the axis with weight 'weight' at position ('x', 'y')
+func (a *Axis2D) fill(x, y, weight float64) {
+	a.dbn.fill(x, y, weight)
+	ix, iy := a.BinIndex(x, y)
+	if ix < 0 || iy < 0 {
+		a.outflows[outflowIndex(outflowRegion(ix), outflowRegion(iy))].fill(x, y, weight)
+		return
+	}
+	a.Bin(ix, iy).fill(x, y, weight)
+}
+
+// Fill the bin ('ix', 'iy') with weight 'weight' at the bin midpoint
+func (a *Axis2D) fillBin(ix, iy int, weight float64) {
+	bin := a.Bin(ix, iy)
+	a.dbn.fill(bin.XMidPoint(), bin.YMidPoint(), weight)
+	bin.fillBin(weight)
+}
+
+// Reset the axis content
+func (a *Axis2D) Reset() {
+	a.dbn.Reset()
+	for i := range a.outflows {
+		a.outflows[i].Reset()
+	}
+	for i := range a.bins {
+		a.bins[i].Reset()
+	}
+}
+
+// Scale the axis weights
+func (a *Axis2D) ScaleW(scale float64) {
+	a.dbn.scaleW(scale)
+	for i := range a.outflows {
+		a.outflows[i].scaleW(scale)
+	}
+	for i := range a.bins {
+		a.bins[i].scaleW(scale)
+	}
+}
+
+// In-place add of 2 axes.
+// The axes are left unchanged if their binnings differ.
+func Axis2D_IAdd(a, b *Axis2D) error {
+	if len(a.bins) != len(b.bins) ||
+		len(a.xedges) != len(b.xedges) ||
+		len(a.yedges) != len(b.yedges) {
+		return fmt.Errorf("iadd: axes' number of bins differ: %w", ErrBinningMismatch)
+	}
+	for i := range a.bins {
+		if a.bins[i].edges != b.bins[i].edges {
+			return fmt.Errorf("iadd: axes' edges of bin %d differ (%v != %v): %w",
+				i, a.bins[i].edges, b.bins[i].edges, ErrBinningMismatch)
+		}
+	}
+	for i := range a.bins {
+		dbn2d_iadd(&a.bins[i].dbn, &b.bins[i].dbn)
+	}
+	for i := range a.outflows {
+		dbn2d_iadd(&a.outflows[i], &b.outflows[i])
+	}
+	return dbn2d_iadd(&a.dbn, &b.dbn)
+}
+
+// project returns the distribution of x-values (or y-values if 'alongy' is
+// true) as a new Axis1D, summing over the in-range bins of the other
+// dimension.
+func (a *Axis2D) project(alongy bool) *Axis1D {
+	edges, uniform := a.xedges, a.xuniform
+	if alongy {
+		edges, uniform = a.yedges, a.yuniform
+	}
+	dbn := func(d *dbn2d) dbn1d {
+		if alongy {
+			return d.ydbn()
+		}
+		return d.xdbn()
+	}
+	// the edges were already validated when creating the Axis2D
+	o, _ := NewAxis1DFromEdges(edges)
+	o.uniform = uniform
+	nx := int(a.NumBinsX())
+	ny := int(a.NumBinsY())
+	for iy := 0; iy < ny; iy++ {
+		for ix := 0; ix < nx; ix++ {
+			id := ix
+			if alongy {
+				id = iy
+			}
+			d := dbn(&a.Bin(ix, iy).dbn)
+			dbn1d_iadd(&o.bins[id].xdbn, &d)
+		}
+	}
+	under := dbn(&a.outflows[outflowIndex(-1, 0)])
+	over := dbn(&a.outflows[outflowIndex(+1, 0)])
+	if alongy {
+		under = dbn(&a.outflows[outflowIndex(0, -1)])
+		over = dbn(&a.outflows[outflowIndex(0, +1)])
+	}
+	o.underflow = under
+	o.overflow = over
+	dbn1d_iadd(&o.dbn, &under)
+	dbn1d_iadd(&o.dbn, &over)
+	for i := range o.bins {
+		dbn1d_iadd(&o.dbn, &o.bins[i].xdbn)
+	}
+	return o
+}
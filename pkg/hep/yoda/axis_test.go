@@ -0,0 +1,371 @@
+package yoda
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAxis1DArithBinningMismatch(t *testing.T) {
+	ref := []float64{0, 1, 2, 3, 4}
+	for _, c := range []struct {
+		name  string
+		edges []float64
+	}{
+		{"number of bins", []float64{0, 1, 2, 3}},
+		{"range", []float64{0, 1, 2, 3, 5}},
+		{"last edge", []float64{0, 1, 2, 3, 4.5}},
+		{"inner edge", []float64{0, 1, 2.5, 3, 4}},
+	} {
+		for _, op := range []struct {
+			name string
+			f    func(a, b *Axis1D) error
+		}{
+			{"iadd", Axis1D_IAdd},
+			{"isub", Axis1D_ISub},
+		} {
+			a := newTestAxis1D(t, ref...)
+			b := newTestAxis1D(t, c.edges...)
+			want := a.Clone()
+			err := op.f(a, b)
+			if !errors.Is(err, ErrBinningMismatch) {
+				t.Errorf("%s, %s: got error %v, want ErrBinningMismatch", op.name, c.name, err)
+			}
+			if !reflect.DeepEqual(a, want) {
+				t.Errorf("%s, %s: axis modified by a failed operation", op.name, c.name)
+			}
+		}
+	}
+
+	a := newTestAxis1D(t, ref...)
+	b := newTestAxis1D(t, ref...)
+	want := a.Clone()
+	err := b.MergeBins(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Axis1D_IAdd(a, b)
+	if !errors.Is(err, ErrBinningMismatch) {
+		t.Errorf("iadd, merged bins: got error %v, want ErrBinningMismatch", err)
+	}
+	if !reflect.DeepEqual(a, want) {
+		t.Errorf("iadd, merged bins: axis modified by a failed operation")
+	}
+}
+
+func TestAxis1DIAdd(t *testing.T) {
+	a := newTestAxis1D(t, 0, 1, 2, 4)
+	b := newTestAxis1D(t, 0, 1, 2, 4)
+	err := Axis1D_IAdd(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range a.bins {
+		if got, want := a.bins[i].SumW(), 2*b.bins[i].SumW(); got != want {
+			t.Errorf("bin %d: got sumw %v, want %v", i, got, want)
+		}
+	}
+	if got, want := a.dbn.sumw, 2*b.dbn.sumw; got != want {
+		t.Errorf("total: got sumw %v, want %v", got, want)
+	}
+}
+
+func TestAxis1DRebin(t *testing.T) {
+	for _, c := range []struct {
+		n       int
+		edges   []float64
+		uniform bool
+	}{
+		{1, []float64{0, 1, 2, 3, 4, 5, 6}, true},
+		{2, []float64{0, 2, 4, 6}, true},
+		{4, []float64{0, 4, 6}, false},
+		{6, []float64{0, 6}, true},
+		{10, []float64{0, 6}, false},
+	} {
+		a, err := NewAxis1D(6, 0, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testFills(20, func(i int, w float64) {
+			a.fill(float64(i%8)-0.5, w)
+		})
+		want := a.Clone()
+		err = a.Rebin(c.n)
+		if err != nil {
+			t.Fatalf("rebin(%d): %v", c.n, err)
+		}
+		if !reflect.DeepEqual(a.edges, c.edges) || a.uniform != c.uniform {
+			t.Errorf("rebin(%d): got edges %v (uniform=%v), want %v (uniform=%v)",
+				c.n, a.edges, a.uniform, c.edges, c.uniform)
+		}
+		k := 0
+		for i := range a.bins {
+			var sumw float64
+			for ; want.edges[k] < a.edges[i+1]; k++ {
+				sumw += want.bins[k].SumW()
+			}
+			if got := a.bins[i].SumW(); got != sumw {
+				t.Errorf("rebin(%d), bin %d: got sumw %v, want %v", c.n, i, got, sumw)
+			}
+		}
+		if a.dbn != want.dbn || a.underflow != want.underflow || a.overflow != want.overflow {
+			t.Errorf("rebin(%d): total or under|over-flow distributions changed", c.n)
+		}
+	}
+
+	a := newTestAxis1D(t, 0, 1, 2)
+	if err := a.Rebin(0); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("rebin(0): got error %v, want %v", err, ErrInvalidRange)
+	}
+}
+
+func TestAxis1DRebinTo(t *testing.T) {
+	ref := []float64{0, 1, 2, 3, 4, 5}
+	a := newTestAxis1D(t, ref...)
+	want := a.Clone()
+	// the bins outside of [1, 3] are moved to the under|over-flows
+	err := a.RebinTo([]float64{1 + 1e-14, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a.edges, []float64{1, 3}) {
+		t.Errorf("got edges %v, want [1 3]", a.edges)
+	}
+	if got, want := a.bins[0].SumW(), want.bins[1].SumW()+want.bins[2].SumW(); got != want {
+		t.Errorf("bin 0: got sumw %v, want %v", got, want)
+	}
+	if got, want := a.underflow.sumw, want.underflow.sumw+want.bins[0].SumW(); got != want {
+		t.Errorf("underflow: got sumw %v, want %v", got, want)
+	}
+	if got, want := a.overflow.sumw, want.overflow.sumw+want.bins[3].SumW()+want.bins[4].SumW(); got != want {
+		t.Errorf("overflow: got sumw %v, want %v", got, want)
+	}
+	if a.dbn != want.dbn {
+		t.Errorf("total distribution changed: got %+v, want %+v", a.dbn, want.dbn)
+	}
+
+	for _, c := range []struct {
+		name  string
+		edges []float64
+		err   error
+	}{
+		{"no match", []float64{0, 1.5, 5}, ErrBinningMismatch},
+		{"out of range", []float64{0, 6}, ErrBinningMismatch},
+		{"same edge", []float64{0, 1, 1 + 1e-14, 5}, ErrBinningMismatch},
+		{"unsorted", []float64{0, 2, 1}, ErrInvalidRange},
+		{"single edge", []float64{1}, ErrInvalidRange},
+	} {
+		a := newTestAxis1D(t, ref...)
+		want := a.Clone()
+		err := a.RebinTo(c.edges)
+		if !errors.Is(err, c.err) {
+			t.Errorf("%s: got error %v, want %v", c.name, err, c.err)
+		}
+		if !reflect.DeepEqual(a, want) {
+			t.Errorf("%s: axis modified by a failed rebinning", c.name)
+		}
+	}
+}
+
+func TestAxis2DIAddBinningMismatch(t *testing.T) {
+	xref := []float64{0, 1, 2}
+	yref := []float64{0, 1, 2, 3}
+	for _, c := range []struct {
+		name           string
+		xedges, yedges []float64
+	}{
+		{"number of x-bins", []float64{0, 1, 2, 3}, yref},
+		{"number of y-bins", xref, []float64{0, 1, 2}},
+		{"transposed", []float64{0, 1, 2, 3}, []float64{0, 1, 2}},
+		{"x-range", []float64{0, 1, 3}, yref},
+		{"y-range", xref, []float64{0, 1, 2, 4}},
+		{"inner y-edge", xref, []float64{0, 1, 2.5, 3}},
+	} {
+		a := newTestAxis2D(t, xref, yref)
+		b := newTestAxis2D(t, c.xedges, c.yedges)
+		want := newTestAxis2D(t, xref, yref)
+		err := Axis2D_IAdd(a, b)
+		if !errors.Is(err, ErrBinningMismatch) {
+			t.Errorf("%s: got error %v, want ErrBinningMismatch", c.name, err)
+		}
+		if !reflect.DeepEqual(a, want) {
+			t.Errorf("%s: axis modified by a failed operation", c.name)
+		}
+	}
+}
+
+func TestProfile1DIAddBinningMismatch(t *testing.T) {
+	ref := []float64{0, 1, 2, 3, 4}
+	for _, c := range []struct {
+		name  string
+		edges []float64
+	}{
+		{"number of bins", []float64{0, 1, 2, 3}},
+		{"range", []float64{0, 1, 2, 3, 5}},
+		{"inner edge", []float64{0, 1, 2, 3.5, 4}},
+	} {
+		a := newTestProfile1D(t, ref...)
+		b := newTestProfile1D(t, c.edges...)
+		want := newTestProfile1D(t, ref...)
+		err := Profile1D_IAdd(a, b)
+		if !errors.Is(err, ErrBinningMismatch) {
+			t.Errorf("%s: got error %v, want ErrBinningMismatch", c.name, err)
+		}
+		if !reflect.DeepEqual(a, want) {
+			t.Errorf("%s: profile modified by a failed operation", c.name)
+		}
+	}
+}
+
+func TestLinspaceEndpoints(t *testing.T) {
+	for _, c := range []struct {
+		lo, hi float64
+		n      int
+	}{
+		{0.1, 0.7, 3},
+		{0.1, 0.8, 7},
+		{-1, 1.1, 7},
+		{1e-3, 1e3, 1000},
+		{-math.Pi, math.Pi, 100},
+	} {
+		edges, err := linspace(c.lo, c.hi, c.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(edges) != c.n+1 || edges[0] != c.lo || edges[c.n] != c.hi {
+			t.Errorf("linspace(%v, %v, %d): got %d edges from %v to %v",
+				c.lo, c.hi, c.n, len(edges), edges[0], edges[len(edges)-1])
+		}
+		a, err := NewAxis1D(c.n, c.lo, c.hi)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.LowEdge() != c.lo || a.HighEdge() != c.hi || !a.uniform {
+			t.Errorf("NewAxis1D(%d, %v, %v): got range [%v, %v] (uniform=%v)",
+				c.n, c.lo, c.hi, a.LowEdge(), a.HighEdge(), a.uniform)
+		}
+	}
+}
+
+func TestNewAxis1DLog(t *testing.T) {
+	a, err := NewAxis1DLog(4, 1, 1e4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// decades are exact
+	if want := []float64{1, 10, 100, 1000, 10000}; !reflect.DeepEqual(a.edges, want) || a.uniform {
+		t.Errorf("got edges %v (uniform=%v), want %v", a.edges, a.uniform, want)
+	}
+	a, err = NewAxis1DLog(7, 0.3, 70)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.LowEdge() != 0.3 || a.HighEdge() != 70 {
+		t.Errorf("got range [%v, %v], want [0.3, 70]", a.LowEdge(), a.HighEdge())
+	}
+	ratio := a.edges[1] / a.edges[0]
+	for i := 1; i < len(a.bins); i++ {
+		if r := a.edges[i+1] / a.edges[i]; math.Abs(r-ratio) > 1e-12 {
+			t.Errorf("bin %d: got edges ratio %v, want %v", i, r, ratio)
+		}
+	}
+
+	for _, c := range []struct {
+		lo, hi float64
+		n      int
+	}{
+		{0, 10, 4},
+		{-1, 10, 4},
+		{10, 1, 4},
+		{1, math.Inf(+1), 4},
+		{1, 10, 0},
+	} {
+		if _, err := NewAxis1DLog(c.n, c.lo, c.hi); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("NewAxis1DLog(%d, %v, %v): got error %v, want %v", c.n, c.lo, c.hi, err, ErrInvalidRange)
+		}
+	}
+}
+
+func TestNewAxis1DPow(t *testing.T) {
+	a, err := NewAxis1DPow(3, 0, 9, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []float64{0, 1, 4, 9}; !reflect.DeepEqual(a.edges, want) || a.uniform {
+		t.Errorf("got edges %v (uniform=%v), want %v", a.edges, a.uniform, want)
+	}
+	a, err = NewAxis1DPow(5, 0.5, 3.7, 1.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.LowEdge() != 0.5 || a.HighEdge() != 3.7 {
+		t.Errorf("got range [%v, %v], want [0.5, 3.7]", a.LowEdge(), a.HighEdge())
+	}
+
+	for _, c := range []struct {
+		lo, hi, power float64
+	}{
+		{-1, 10, 2},
+		{1, 10, 0},
+		{1, 10, -2},
+		{1, 10, math.NaN()},
+		{10, 1, 2},
+	} {
+		if _, err := NewAxis1DPow(4, c.lo, c.hi, c.power); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("NewAxis1DPow(4, %v, %v, %v): got error %v, want %v", c.lo, c.hi, c.power, err, ErrInvalidRange)
+		}
+	}
+}
+
+func TestNewAxis1DFromQuantiles(t *testing.T) {
+	sample := make([]float64, 100)
+	for i := range sample {
+		// unsorted
+		sample[i] = float64((37 * i) % 100)
+	}
+	a, err := NewAxis1DFromQuantiles(sample, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []float64{0, 24.75, 49.5, 74.25, math.Nextafter(99, 100)}
+	if !reflect.DeepEqual(a.edges, want) {
+		t.Errorf("got edges %v, want %v", a.edges, want)
+	}
+	for _, x := range sample {
+		a.fill(x, 1)
+	}
+	for i := range a.bins {
+		if n := a.bins[i].NumEntries(); n != 25 {
+			t.Errorf("bin %d: got %d entries, want 25", i, n)
+		}
+	}
+	if a.underflow.nfills != 0 || a.overflow.nfills != 0 {
+		t.Errorf("got %d underflows and %d overflows, want 0", a.underflow.nfills, a.overflow.nfills)
+	}
+
+	// coinciding quantiles are merged
+	a, err = NewAxis1DFromQuantiles([]float64{1, 1, 1, 1, 2}, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []float64{1, math.Nextafter(2, 3)}; !reflect.DeepEqual(a.edges, want) {
+		t.Errorf("repeated values: got edges %v, want %v", a.edges, want)
+	}
+
+	for _, c := range []struct {
+		name   string
+		sample []float64
+		n      int
+	}{
+		{"no sample", nil, 4},
+		{"single value", []float64{1}, 4},
+		{"equal values", []float64{2, 2, 2}, 4},
+		{"NaN", []float64{0, 1, math.NaN()}, 2},
+		{"no bins", []float64{0, 1, 2}, 0},
+	} {
+		if _, err := NewAxis1DFromQuantiles(c.sample, c.n); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("%s: got error %v, want %v", c.name, err, ErrInvalidRange)
+		}
+	}
+}
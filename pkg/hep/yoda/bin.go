@@ -1,7 +1,7 @@
 package yoda
 
 import (
-	"errors"
+	"fmt"
 	"math"
 
 	//"sort"
@@ -60,7 +60,7 @@ func (b *Bin1D) Width() float64 {
 // Returns the mean position in the bin, or the midpoint if that is not available
 func (b *Bin1D) Focus() float64 {
 	if b.xdbn.sumw != 0.0 {
-		return b.XMean()
+		return b.xdbn.sumwx / b.xdbn.sumw
 	}
 	return b.MidPoint()
 }
@@ -71,35 +71,23 @@ func (b *Bin1D) MidPoint() float64 {
 }
 
 // Returns the mean value of x-values in the bin.
-func (b *Bin1D) XMean() float64 {
+func (b *Bin1D) XMean() (float64, error) {
 	return b.xdbn.mean()
 }
 
 // Returns the variance of x-values in the bin
-func (b *Bin1D) XVariance() float64 {
-	v, err := b.xdbn.variance()
-	if err != nil {
-		panic(err)
-	}
-	return v
+func (b *Bin1D) XVariance() (float64, error) {
+	return b.xdbn.variance()
 }
 
 // Returns the standard deviation (spread) of x-values in the bin
-func (b *Bin1D) XStdDev() float64 {
-	v, err := b.xdbn.stdDev()
-	if err != nil {
-		panic(err)
-	}
-	return v
+func (b *Bin1D) XStdDev() (float64, error) {
+	return b.xdbn.stdDev()
 }
 
 // Returns the standard error on the bin focus
-func (b *Bin1D) XStdError() float64 {
-	v, err := b.xdbn.stdErr()
-	if err != nil {
-		panic(err)
-	}
-	return v
+func (b *Bin1D) XStdError() (float64, error) {
+	return b.xdbn.stdErr()
 }
 
 // Returns the number of entries in the bin
@@ -145,7 +133,7 @@ func Bin1D_Add(a, b *Bin1D) *Bin1D {
 func Bin1D_IAdd(a, b *Bin1D) error {
 	if a.edges[0] != b.edges[0] ||
 		a.edges[1] != b.edges[1] {
-		return errors.New("iadd: axes' edges to not match")
+		return fmt.Errorf("iadd: bins' edges do not match: %w", ErrBinningMismatch)
 	}
 	return dbn1d_iadd(&a.xdbn, &b.xdbn)
 }
@@ -187,7 +175,7 @@ func (d *dbn1d) scaleW(factor float64) {
 	d.sumw *= factor
 	d.sumw2 *= sf2
 	d.sumwx *= factor
-	d.sumwx2 *= sf2
+	d.sumwx2 *= factor
 }
 
 func (d *dbn1d) fill(val, weight float64) {
@@ -210,32 +198,60 @@ func (d *dbn1d) Reset() {
 	d.sumwx2 = 0.0
 }
 
+// Returns the number of fills
+func (d *dbn1d) NumEntries() uint64 {
+	return d.nfills
+}
+
+// Returns the sum of weights
+func (d *dbn1d) SumW() float64 {
+	return d.sumw
+}
+
+// Returns the sum of weights squared
+func (d *dbn1d) SumW2() float64 {
+	return d.sumw2
+}
+
+// Returns the sum of x*weight
+func (d *dbn1d) SumWX() float64 {
+	return d.sumwx
+}
+
+// Returns the sum of x**2 * weight
+func (d *dbn1d) SumWX2() float64 {
+	return d.sumwx2
+}
+
 func (d *dbn1d) effNumEntries() float64 {
 	return d.sumw * d.sumw / d.sumw2
 }
 
-func (d *dbn1d) mean() float64 {
-	return d.sumwx / d.sumw
+func (d *dbn1d) mean() (float64, error) {
+	if d.sumw == 0.0 {
+		return 0.0, fmt.Errorf("requested mean of a distribution with no net fill weights: %w", ErrLowStats)
+	}
+	return d.sumwx / d.sumw, nil
 }
 
 // The weighted variance is defined as:
 //  sig2 = (sum(wx**2) * sum(w) - sum(wx)**2) / (sum(w)**2 - sum(w**2))
 //  http://en.wikipedia.org/wiki/Weighted_mean
 func (d *dbn1d) variance() (float64, error) {
-	effn := d.effNumEntries()
-	if effn == 0.0 {
-		return 0.0, errors.New("requested width of a distribution with no net fill weights")
+	if d.sumw == 0.0 {
+		return 0.0, fmt.Errorf("requested width of a distribution with no net fill weights: %w", ErrLowStats)
 	}
+	effn := d.effNumEntries()
 	if effn <= 1.0 {
-		return 0.0, errors.New("requested width of a distribution with only one effective entry")
+		return 0.0, fmt.Errorf("requested width of a distribution with only one effective entry: %w", ErrLowStats)
 	}
 	num := d.sumwx2*d.sumw - d.sumwx*d.sumwx
 	den := d.sumw*d.sumw - d.sumw2
 	if den == 0 {
-		panic("undefined weighted variance")
+		return 0.0, fmt.Errorf("undefined weighted variance: %w", ErrLowStats)
 	}
 	if math.Abs(num) < 1e-10 && math.Abs(den) < 1e-10 {
-		panic("numerically unstable weights in width calculation")
+		return 0.0, fmt.Errorf("numerically unstable weights in width calculation: %w", ErrLowStats)
 	}
 	return num / den, nil
 }
@@ -249,10 +265,10 @@ func (d *dbn1d) stdDev() (float64, error) {
 }
 
 func (d *dbn1d) stdErr() (float64, error) {
-	effnum := d.effNumEntries()
-	if effnum == 0.0 {
-		return 0.0, errors.New("requested std error of a distribution with no net fill weights")
+	if d.sumw == 0.0 {
+		return 0.0, fmt.Errorf("requested std error of a distribution with no net fill weights: %w", ErrLowStats)
 	}
+	effnum := d.effNumEntries()
 	v, err := d.variance()
 	if err != nil {
 		return 0.0, err
@@ -279,20 +295,23 @@ func dbn1d_iadd(a, b *dbn1d) error {
 	return nil
 }
 
+// Returns a new distribution, the subtraction of the input distributions.
+// The sums of squared weights are added, so that errors on the difference
+// are propagated in quadrature.
 func dbn1d_sub(a, b *dbn1d) *dbn1d {
-	return &dbn1d{
-		nfills: a.nfills + b.nfills,
-		sumw:   a.sumw - b.sumw,
-		sumw2:  a.sumw2 - b.sumw2,
-		sumwx:  a.sumwx - b.sumwx,
-		sumwx2: a.sumwx2 - b.sumwx2,
-	}
+	o := *a
+	dbn1d_isub(&o, b)
+	return &o
 }
 
 func dbn1d_isub(a, b *dbn1d) error {
-	a.nfills += b.nfills
+	if a.nfills > b.nfills {
+		a.nfills -= b.nfills
+	} else {
+		a.nfills = 0
+	}
 	a.sumw -= b.sumw
-	a.sumw2 -= b.sumw2
+	a.sumw2 += b.sumw2
 	a.sumwx -= b.sumwx
 	a.sumwx2 -= b.sumwx2
 	return nil
@@ -0,0 +1,288 @@
+package yoda
+
+import (
+	"fmt"
+	"math"
+)
+
+// Bin2D is a bin in a 2D histogram.
+// The lower bin edges are inclusive
+type Bin2D struct {
+	// the bin limits: {{xlow, xhigh}, {ylow, yhigh}}
+	edges [2][2]float64
+
+	// distribution of weighted (x,y)-values
+	dbn dbn2d
+}
+
+// Create a new Bin2D given low and high edges in x and y
+func NewBin2D(xlow, xhi, ylow, yhi float64) *Bin2D {
+	return &Bin2D{edges: [2][2]float64{{xlow, xhi}, {ylow, yhi}}, dbn: dbn2d{}}
+}
+
+// Returns the lower x-limit of the bin (inclusive)
+func (b *Bin2D) XMin() float64 {
+	return b.edges[0][0]
+}
+
+// Returns the upper x-limit of the bin (exclusive)
+func (b *Bin2D) XMax() float64 {
+	return b.edges[0][1]
+}
+
+// Returns the lower y-limit of the bin (inclusive)
+func (b *Bin2D) YMin() float64 {
+	return b.edges[1][0]
+}
+
+// Returns the upper y-limit of the bin (exclusive)
+func (b *Bin2D) YMax() float64 {
+	return b.edges[1][1]
+}
+
+// Returns the width of the bin along x
+func (b *Bin2D) XWidth() float64 {
+	return b.edges[0][1] - b.edges[0][0]
+}
+
+// Returns the width of the bin along y
+func (b *Bin2D) YWidth() float64 {
+	return b.edges[1][1] - b.edges[1][0]
+}
+
+// Returns the geometric area of the bin
+func (b *Bin2D) Area() float64 {
+	return b.XWidth() * b.YWidth()
+}
+
+// Returns the geometric centre of the bin along x
+func (b *Bin2D) XMidPoint() float64 {
+	return (b.edges[0][1] + b.edges[0][0]) / 2.0
+}
+
+// Returns the geometric centre of the bin along y
+func (b *Bin2D) YMidPoint() float64 {
+	return (b.edges[1][1] + b.edges[1][0]) / 2.0
+}
+
+// Reset this bin
+func (b *Bin2D) Reset() {
+	b.dbn.Reset()
+}
+
+// Returns the mean value of x-values in the bin
+func (b *Bin2D) XMean() (float64, error) {
+	return b.dbn.xMean()
+}
+
+// Returns the mean value of y-values in the bin
+func (b *Bin2D) YMean() (float64, error) {
+	return b.dbn.yMean()
+}
+
+// Returns the number of entries in the bin
+func (b *Bin2D) NumEntries() uint64 {
+	return b.dbn.nfills
+}
+
+// Returns the sum of weights
+func (b *Bin2D) SumW() float64 {
+	return b.dbn.sumw
+}
+
+// Returns the sum of weights squared
+func (b *Bin2D) SumW2() float64 {
+	return b.dbn.sumw2
+}
+
+// Returns the sum of x*weight
+func (b *Bin2D) SumWX() float64 {
+	return b.dbn.sumwx
+}
+
+// Returns the sum of x**2 * weight
+func (b *Bin2D) SumWX2() float64 {
+	return b.dbn.sumwx2
+}
+
+// Returns the sum of y*weight
+func (b *Bin2D) SumWY() float64 {
+	return b.dbn.sumwy
+}
+
+// Returns the sum of y**2 * weight
+func (b *Bin2D) SumWY2() float64 {
+	return b.dbn.sumwy2
+}
+
+// Returns the sum of x*y*weight
+func (b *Bin2D) SumWXY() float64 {
+	return b.dbn.sumwxy
+}
+
+func (b *Bin2D) fill(x, y, weight float64) {
+	b.dbn.fill(x, y, weight)
+}
+
+func (b *Bin2D) fillBin(weight float64) {
+	b.dbn.fill(b.XMidPoint(), b.YMidPoint(), weight)
+}
+
+func (b *Bin2D) scaleW(scale float64) {
+	b.dbn.scaleW(scale)
+}
+
+// in-place sum of the input bins: a += b
+func Bin2D_IAdd(a, b *Bin2D) error {
+	if a.edges != b.edges {
+		return fmt.Errorf("iadd: bins' edges do not match: %w", ErrBinningMismatch)
+	}
+	return dbn2d_iadd(&a.dbn, &b.dbn)
+}
+
+// dbn2d is a 2D distribution.
+// Each distribution fill contributes a weight 'w' and values 'x' and 'y'.
+// On top of the moments tracked by dbn1d for each of the 2 dimensions,
+// dbn2d also stores Sum(w.x.y), so that the covariance and correlation of
+// x and y can be calculated.
+type dbn2d struct {
+	nfills uint64
+	sumw   float64
+	sumw2  float64
+	sumwx  float64
+	sumwx2 float64
+	sumwy  float64
+	sumwy2 float64
+	sumwxy float64
+}
+
+func (d *dbn2d) scaleW(factor float64) {
+	sf2 := factor * factor
+	d.sumw *= factor
+	d.sumw2 *= sf2
+	d.sumwx *= factor
+	d.sumwx2 *= factor
+	d.sumwy *= factor
+	d.sumwy2 *= factor
+	d.sumwxy *= factor
+}
+
+func (d *dbn2d) fill(x, y, weight float64) {
+	d.nfills += 1
+	d.sumw += weight
+	w2 := weight * weight
+	if weight < 0. {
+		w2 *= -1.0
+	}
+	d.sumw2 += w2
+	d.sumwx += weight * x
+	d.sumwx2 += weight * x * x
+	d.sumwy += weight * y
+	d.sumwy2 += weight * y * y
+	d.sumwxy += weight * x * y
+}
+
+func (d *dbn2d) Reset() {
+	*d = dbn2d{}
+}
+
+// Returns the number of fills
+func (d *dbn2d) NumEntries() uint64 {
+	return d.nfills
+}
+
+// Returns the sum of weights
+func (d *dbn2d) SumW() float64 {
+	return d.sumw
+}
+
+// Returns the sum of weights squared
+func (d *dbn2d) SumW2() float64 {
+	return d.sumw2
+}
+
+// Returns the distribution of x-values
+func (d *dbn2d) xdbn() dbn1d {
+	return dbn1d{
+		nfills: d.nfills,
+		sumw:   d.sumw,
+		sumw2:  d.sumw2,
+		sumwx:  d.sumwx,
+		sumwx2: d.sumwx2,
+	}
+}
+
+// Returns the distribution of y-values
+func (d *dbn2d) ydbn() dbn1d {
+	return dbn1d{
+		nfills: d.nfills,
+		sumw:   d.sumw,
+		sumw2:  d.sumw2,
+		sumwx:  d.sumwy,
+		sumwx2: d.sumwy2,
+	}
+}
+
+func (d *dbn2d) effNumEntries() float64 {
+	return d.sumw * d.sumw / d.sumw2
+}
+
+func (d *dbn2d) xMean() (float64, error) {
+	x := d.xdbn()
+	return x.mean()
+}
+
+func (d *dbn2d) yMean() (float64, error) {
+	y := d.ydbn()
+	return y.mean()
+}
+
+// The weighted covariance is defined as:
+//  cov = (sum(wxy) * sum(w) - sum(wx)*sum(wy)) / (sum(w)**2 - sum(w**2))
+func (d *dbn2d) covariance() (float64, error) {
+	if d.sumw == 0.0 {
+		return 0.0, fmt.Errorf("requested covariance of a distribution with no net fill weights: %w", ErrLowStats)
+	}
+	effn := d.effNumEntries()
+	if effn <= 1.0 {
+		return 0.0, fmt.Errorf("requested covariance of a distribution with only one effective entry: %w", ErrLowStats)
+	}
+	num := d.sumwxy*d.sumw - d.sumwx*d.sumwy
+	den := d.sumw*d.sumw - d.sumw2
+	if den == 0 {
+		return 0.0, fmt.Errorf("undefined weighted covariance: %w", ErrLowStats)
+	}
+	return num / den, nil
+}
+
+// The correlation is the covariance normalized by the x and y standard
+// deviations
+func (d *dbn2d) correlation() (float64, error) {
+	cov, err := d.covariance()
+	if err != nil {
+		return 0.0, err
+	}
+	xdbn := d.xdbn()
+	ydbn := d.ydbn()
+	xvar, err := xdbn.variance()
+	if err != nil {
+		return 0.0, err
+	}
+	yvar, err := ydbn.variance()
+	if err != nil {
+		return 0.0, err
+	}
+	return cov / math.Sqrt(xvar*yvar), nil
+}
+
+func dbn2d_iadd(a, b *dbn2d) error {
+	a.nfills += b.nfills
+	a.sumw += b.sumw
+	a.sumw2 += b.sumw2
+	a.sumwx += b.sumwx
+	a.sumwx2 += b.sumwx2
+	a.sumwy += b.sumwy
+	a.sumwy2 += b.sumwy2
+	a.sumwxy += b.sumwxy
+	return nil
+}
@@ -0,0 +1,881 @@
+package yoda
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Binary encoding of the yoda types.
+//
+// All values are little-endian. An encoded object starts with a 2-byte
+// header: the version of the layout (binaryVersion) and a tag identifying
+// the encoded type. The header is followed by the fields of the object:
+//
+//  uint64, float64:  8 bytes (float64 as its IEEE-754 bits)
+//  []float64:        uint32 length, then the values
+//  string:           uint32 length, then the bytes
+//  dbn1d:            nfills, sumw, sumw2, sumwx, sumwx2
+//  dbn2d:            nfills, sumw, sumw2, sumwx, sumwx2, sumwy, sumwy2, sumwxy
+//  Annotations:      uint32 count, then (key, type tag, value) sorted by key
+//
+// Bins nested in an axis are stored without their edges, which are
+// rebuilt from the edges of the axis.
+
+// version of the binary layout
+const binaryVersion = 1
+
+// tags of the encoded types
+const (
+	binDbn1D byte = iota + 1
+	binDbn2D
+	binBin1D
+	binPbin1D
+	binBin2D
+	binAxis1D
+	binAxis2D
+	binHisto1D
+	binHisto2D
+	binProfile1D
+	binPoint2D
+	binScatter2D
+)
+
+// tags of the annotation values
+const (
+	annString byte = iota + 1
+	annFloat64
+	annFloat32
+	annInt
+	annInt64
+	annInt32
+	annUint64
+	annBool
+)
+
+// wbuf accumulates the binary encoding of a value
+type wbuf struct {
+	p   []byte
+	err error
+}
+
+func (w *wbuf) u8(v byte) {
+	w.p = append(w.p, v)
+}
+
+func (w *wbuf) u32(v uint32) {
+	w.p = binary.LittleEndian.AppendUint32(w.p, v)
+}
+
+func (w *wbuf) u64(v uint64) {
+	w.p = binary.LittleEndian.AppendUint64(w.p, v)
+}
+
+func (w *wbuf) flag(v bool) {
+	if v {
+		w.u8(1)
+	} else {
+		w.u8(0)
+	}
+}
+
+func (w *wbuf) f64(v float64) {
+	w.u64(math.Float64bits(v))
+}
+
+func (w *wbuf) f64s(v []float64) {
+	w.u32(uint32(len(v)))
+	for _, x := range v {
+		w.f64(x)
+	}
+}
+
+func (w *wbuf) str(v string) {
+	w.u32(uint32(len(v)))
+	w.p = append(w.p, v...)
+}
+
+func (w *wbuf) header(tag byte) {
+	w.u8(binaryVersion)
+	w.u8(tag)
+}
+
+func (w *wbuf) annotations(ann Annotations) {
+	keys := make([]string, 0, len(ann))
+	for k := range ann {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	w.u32(uint32(len(keys)))
+	for _, k := range keys {
+		w.str(k)
+		switch v := ann[k].(type) {
+		case string:
+			w.u8(annString)
+			w.str(v)
+		case float64:
+			w.u8(annFloat64)
+			w.f64(v)
+		case float32:
+			w.u8(annFloat32)
+			w.f64(float64(v))
+		case int:
+			w.u8(annInt)
+			w.u64(uint64(v))
+		case int64:
+			w.u8(annInt64)
+			w.u64(uint64(v))
+		case int32:
+			w.u8(annInt32)
+			w.u64(uint64(v))
+		case uint64:
+			w.u8(annUint64)
+			w.u64(v)
+		case bool:
+			w.u8(annBool)
+			w.flag(v)
+		default:
+			if w.err == nil {
+				w.err = fmt.Errorf("yoda: cannot encode annotation %q of type %T", k, v)
+			}
+			return
+		}
+	}
+}
+
+// rbuf decodes a binary encoding. The first error is recorded and the
+// subsequent reads return zero values.
+type rbuf struct {
+	p   []byte
+	err error
+}
+
+func (r *rbuf) fail(format string, args ...interface{}) {
+	if r.err == nil {
+		r.err = fmt.Errorf("yoda: "+format+": %w", append(args, ErrInvalidEncoding)...)
+	}
+}
+
+func (r *rbuf) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(r.p) {
+		r.fail("truncated data")
+		return nil
+	}
+	b := r.p[:n]
+	r.p = r.p[n:]
+	return b
+}
+
+func (r *rbuf) u8() byte {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *rbuf) u32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (r *rbuf) u64() uint64 {
+	b := r.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+func (r *rbuf) f64() float64 {
+	return math.Float64frombits(r.u64())
+}
+
+// size reads a length, checking 'elem'-byte long elements fit in the
+// remaining data
+func (r *rbuf) size(elem int) int {
+	n := int(r.u32())
+	if r.err == nil && n > len(r.p)/elem {
+		r.fail("truncated data")
+		return 0
+	}
+	return n
+}
+
+func (r *rbuf) f64s() []float64 {
+	n := r.size(8)
+	if r.err != nil {
+		return nil
+	}
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = r.f64()
+	}
+	return v
+}
+
+func (r *rbuf) str() string {
+	return string(r.next(r.size(1)))
+}
+
+func (r *rbuf) header(tag byte) {
+	if v := r.u8(); r.err == nil && v != binaryVersion {
+		r.fail("unsupported binary version %d", v)
+	}
+	if v := r.u8(); r.err == nil && v != tag {
+		r.fail("unexpected type tag %d (want %d)", v, tag)
+	}
+}
+
+// end checks all the data was consumed
+func (r *rbuf) end() error {
+	if r.err == nil && len(r.p) != 0 {
+		r.fail("%d trailing bytes", len(r.p))
+	}
+	return r.err
+}
+
+func (r *rbuf) annotations() Annotations {
+	n := r.size(6)
+	if r.err != nil || n == 0 {
+		return nil
+	}
+	ann := make(Annotations, n)
+	for i := 0; i < n && r.err == nil; i++ {
+		k := r.str()
+		switch tag := r.u8(); tag {
+		case annString:
+			ann[k] = r.str()
+		case annFloat64:
+			ann[k] = r.f64()
+		case annFloat32:
+			ann[k] = float32(r.f64())
+		case annInt:
+			ann[k] = int(r.u64())
+		case annInt64:
+			ann[k] = int64(r.u64())
+		case annInt32:
+			ann[k] = int32(r.u64())
+		case annUint64:
+			ann[k] = r.u64()
+		case annBool:
+			ann[k] = r.u8() != 0
+		default:
+			r.fail("invalid annotation type tag %d", tag)
+		}
+	}
+	return ann
+}
+
+// edges reads a list of edges, which must define at least one bin
+func (r *rbuf) edges() []float64 {
+	edges := r.f64s()
+	if r.err != nil {
+		return nil
+	}
+	if err := checkEdges(edges); err != nil {
+		r.fail("%v", err)
+		return nil
+	}
+	return edges
+}
+
+// encoding of the fields of each type, without header
+
+func (d *dbn1d) encode(w *wbuf) {
+	w.u64(d.nfills)
+	w.f64(d.sumw)
+	w.f64(d.sumw2)
+	w.f64(d.sumwx)
+	w.f64(d.sumwx2)
+}
+
+func (d *dbn1d) decode(r *rbuf) {
+	d.nfills = r.u64()
+	d.sumw = r.f64()
+	d.sumw2 = r.f64()
+	d.sumwx = r.f64()
+	d.sumwx2 = r.f64()
+}
+
+func (d *dbn2d) encode(w *wbuf) {
+	w.u64(d.nfills)
+	w.f64(d.sumw)
+	w.f64(d.sumw2)
+	w.f64(d.sumwx)
+	w.f64(d.sumwx2)
+	w.f64(d.sumwy)
+	w.f64(d.sumwy2)
+	w.f64(d.sumwxy)
+}
+
+func (d *dbn2d) decode(r *rbuf) {
+	d.nfills = r.u64()
+	d.sumw = r.f64()
+	d.sumw2 = r.f64()
+	d.sumwx = r.f64()
+	d.sumwx2 = r.f64()
+	d.sumwy = r.f64()
+	d.sumwy2 = r.f64()
+	d.sumwxy = r.f64()
+}
+
+func (b *Bin1D) encode(w *wbuf) {
+	w.f64(b.edges[0])
+	w.f64(b.edges[1])
+	b.xdbn.encode(w)
+}
+
+func (b *Bin1D) decode(r *rbuf) {
+	b.edges[0] = r.f64()
+	b.edges[1] = r.f64()
+	b.xdbn.decode(r)
+}
+
+func (b *pbin1d) encode(w *wbuf) {
+	b.Bin1D.encode(w)
+	b.ydbn.encode(w)
+}
+
+func (b *pbin1d) decode(r *rbuf) {
+	b.Bin1D.decode(r)
+	b.ydbn.decode(r)
+}
+
+func (b *Bin2D) encode(w *wbuf) {
+	for i := range b.edges {
+		w.f64(b.edges[i][0])
+		w.f64(b.edges[i][1])
+	}
+	b.dbn.encode(w)
+}
+
+func (b *Bin2D) decode(r *rbuf) {
+	for i := range b.edges {
+		b.edges[i][0] = r.f64()
+		b.edges[i][1] = r.f64()
+	}
+	b.dbn.decode(r)
+}
+
+func (a *Axis1D) encode(w *wbuf) {
+	w.f64s(a.edges)
+	w.flag(a.uniform)
+	for i := range a.bins {
+		a.bins[i].xdbn.encode(w)
+	}
+	a.underflow.encode(w)
+	a.overflow.encode(w)
+	a.dbn.encode(w)
+}
+
+func (a *Axis1D) decode(r *rbuf) {
+	edges := r.edges()
+	uniform := r.u8() != 0
+	if r.err != nil {
+		return
+	}
+	o, _ := NewAxis1DFromEdges(edges)
+	o.uniform = uniform
+	for i := range o.bins {
+		o.bins[i].xdbn.decode(r)
+	}
+	o.underflow.decode(r)
+	o.overflow.decode(r)
+	o.dbn.decode(r)
+	if r.err == nil {
+		*a = *o
+	}
+}
+
+func (a *Axis2D) encode(w *wbuf) {
+	w.f64s(a.xedges)
+	w.f64s(a.yedges)
+	w.flag(a.xuniform)
+	w.flag(a.yuniform)
+	for i := range a.bins {
+		a.bins[i].dbn.encode(w)
+	}
+	for i := range a.outflows {
+		a.outflows[i].encode(w)
+	}
+	a.dbn.encode(w)
+}
+
+func (a *Axis2D) decode(r *rbuf) {
+	xedges := r.edges()
+	yedges := r.edges()
+	xuniform := r.u8() != 0
+	yuniform := r.u8() != 0
+	if r.err != nil {
+		return
+	}
+	if (len(xedges)-1)*(len(yedges)-1) > len(r.p)/64 {
+		r.fail("truncated data")
+		return
+	}
+	o, _ := NewAxis2DFromEdges(xedges, yedges)
+	o.xuniform = xuniform
+	o.yuniform = yuniform
+	for i := range o.bins {
+		o.bins[i].dbn.decode(r)
+	}
+	for i := range o.outflows {
+		o.outflows[i].decode(r)
+	}
+	o.dbn.decode(r)
+	if r.err == nil {
+		*a = *o
+	}
+}
+
+func (p *Point2D) encode(w *wbuf) {
+	w.f64(p.coord[0])
+	w.f64(p.coord[1])
+	for i := range p.err {
+		w.f64(p.err[i][0])
+		w.f64(p.err[i][1])
+	}
+}
+
+func (p *Point2D) decode(r *rbuf) {
+	p.coord[0] = r.f64()
+	p.coord[1] = r.f64()
+	for i := range p.err {
+		p.err[i][0] = r.f64()
+		p.err[i][1] = r.f64()
+	}
+}
+
+// marshal encodes 'v' with the header 'tag'
+func marshal(tag byte, v interface{ encode(*wbuf) }) ([]byte, error) {
+	w := &wbuf{}
+	w.header(tag)
+	v.encode(w)
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.p, nil
+}
+
+// unmarshal decodes 'data', checking its header is 'tag'
+func unmarshal(data []byte, tag byte, v interface{ decode(*rbuf) }) error {
+	r := &rbuf{p: data}
+	r.header(tag)
+	if r.err != nil {
+		return r.err
+	}
+	v.decode(r)
+	return r.end()
+}
+
+// dbn1d
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (d dbn1d) MarshalBinary() ([]byte, error) {
+	return marshal(binDbn1D, &d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (d *dbn1d) UnmarshalBinary(data []byte) error {
+	var o dbn1d
+	err := unmarshal(data, binDbn1D, &o)
+	if err == nil {
+		*d = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (d dbn1d) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (d *dbn1d) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// dbn2d
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (d dbn2d) MarshalBinary() ([]byte, error) {
+	return marshal(binDbn2D, &d)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (d *dbn2d) UnmarshalBinary(data []byte) error {
+	var o dbn2d
+	err := unmarshal(data, binDbn2D, &o)
+	if err == nil {
+		*d = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (d dbn2d) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (d *dbn2d) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// Bin1D (and hbin1d, which embeds it)
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (b Bin1D) MarshalBinary() ([]byte, error) {
+	return marshal(binBin1D, &b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (b *Bin1D) UnmarshalBinary(data []byte) error {
+	var o Bin1D
+	err := unmarshal(data, binBin1D, &o)
+	if err == nil {
+		*b = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (b Bin1D) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (b *Bin1D) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// pbin1d: the methods promoted from Bin1D would drop the y distribution
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (b pbin1d) MarshalBinary() ([]byte, error) {
+	return marshal(binPbin1D, &b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (b *pbin1d) UnmarshalBinary(data []byte) error {
+	var o pbin1d
+	err := unmarshal(data, binPbin1D, &o)
+	if err == nil {
+		*b = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (b pbin1d) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (b *pbin1d) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// Bin2D
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (b Bin2D) MarshalBinary() ([]byte, error) {
+	return marshal(binBin2D, &b)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (b *Bin2D) UnmarshalBinary(data []byte) error {
+	var o Bin2D
+	err := unmarshal(data, binBin2D, &o)
+	if err == nil {
+		*b = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (b Bin2D) GobEncode() ([]byte, error) {
+	return b.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (b *Bin2D) GobDecode(data []byte) error {
+	return b.UnmarshalBinary(data)
+}
+
+// Axis1D
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (a Axis1D) MarshalBinary() ([]byte, error) {
+	return marshal(binAxis1D, &a)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (a *Axis1D) UnmarshalBinary(data []byte) error {
+	var o Axis1D
+	err := unmarshal(data, binAxis1D, &o)
+	if err == nil {
+		*a = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (a Axis1D) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (a *Axis1D) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
+
+// Axis2D
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (a Axis2D) MarshalBinary() ([]byte, error) {
+	return marshal(binAxis2D, &a)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (a *Axis2D) UnmarshalBinary(data []byte) error {
+	var o Axis2D
+	err := unmarshal(data, binAxis2D, &o)
+	if err == nil {
+		*a = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (a Axis2D) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (a *Axis2D) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
+
+// Histo1D
+
+func (h *Histo1D) encode(w *wbuf) {
+	w.annotations(h.ann)
+	h.axis.encode(w)
+}
+
+func (h *Histo1D) decode(r *rbuf) {
+	ann := r.annotations()
+	h.axis.decode(r)
+	h.ann = ann
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (h Histo1D) MarshalBinary() ([]byte, error) {
+	return marshal(binHisto1D, &h)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (h *Histo1D) UnmarshalBinary(data []byte) error {
+	var o Histo1D
+	err := unmarshal(data, binHisto1D, &o)
+	if err == nil {
+		*h = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (h Histo1D) GobEncode() ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (h *Histo1D) GobDecode(data []byte) error {
+	return h.UnmarshalBinary(data)
+}
+
+// Histo2D
+
+func (h *Histo2D) encode(w *wbuf) {
+	w.annotations(h.ann)
+	h.axis.encode(w)
+}
+
+func (h *Histo2D) decode(r *rbuf) {
+	ann := r.annotations()
+	h.axis.decode(r)
+	h.ann = ann
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (h Histo2D) MarshalBinary() ([]byte, error) {
+	return marshal(binHisto2D, &h)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (h *Histo2D) UnmarshalBinary(data []byte) error {
+	var o Histo2D
+	err := unmarshal(data, binHisto2D, &o)
+	if err == nil {
+		*h = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (h Histo2D) GobEncode() ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (h *Histo2D) GobDecode(data []byte) error {
+	return h.UnmarshalBinary(data)
+}
+
+// Profile1D
+
+func (p *Profile1D) encode(w *wbuf) {
+	w.annotations(p.ann)
+	w.f64s(p.edges)
+	w.flag(p.uniform)
+	for i := range p.bins {
+		p.bins[i].xdbn.encode(w)
+		p.bins[i].ydbn.encode(w)
+	}
+	for _, b := range []*pbin1d{&p.underflow, &p.overflow, &p.dbn} {
+		b.xdbn.encode(w)
+		b.ydbn.encode(w)
+	}
+}
+
+func (p *Profile1D) decode(r *rbuf) {
+	ann := r.annotations()
+	edges := r.edges()
+	uniform := r.u8() != 0
+	if r.err != nil {
+		return
+	}
+	o, _ := NewProfile1DFromEdges(edges)
+	o.ann = ann
+	o.uniform = uniform
+	for i := range o.bins {
+		o.bins[i].xdbn.decode(r)
+		o.bins[i].ydbn.decode(r)
+	}
+	for _, b := range []*pbin1d{&o.underflow, &o.overflow, &o.dbn} {
+		b.xdbn.decode(r)
+		b.ydbn.decode(r)
+	}
+	if r.err == nil {
+		*p = *o
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (p Profile1D) MarshalBinary() ([]byte, error) {
+	return marshal(binProfile1D, &p)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (p *Profile1D) UnmarshalBinary(data []byte) error {
+	var o Profile1D
+	err := unmarshal(data, binProfile1D, &o)
+	if err == nil {
+		*p = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (p Profile1D) GobEncode() ([]byte, error) {
+	return p.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (p *Profile1D) GobDecode(data []byte) error {
+	return p.UnmarshalBinary(data)
+}
+
+// Point2D
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (p Point2D) MarshalBinary() ([]byte, error) {
+	return marshal(binPoint2D, &p)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (p *Point2D) UnmarshalBinary(data []byte) error {
+	var o Point2D
+	err := unmarshal(data, binPoint2D, &o)
+	if err == nil {
+		*p = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (p Point2D) GobEncode() ([]byte, error) {
+	return p.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (p *Point2D) GobDecode(data []byte) error {
+	return p.UnmarshalBinary(data)
+}
+
+// Scatter2D
+
+func (s *Scatter2D) encode(w *wbuf) {
+	w.annotations(s.ann)
+	w.u32(uint32(len(s.points)))
+	for _, p := range s.points {
+		p.encode(w)
+	}
+}
+
+func (s *Scatter2D) decode(r *rbuf) {
+	s.ann = r.annotations()
+	n := r.size(48)
+	if r.err != nil {
+		return
+	}
+	s.points = make([]*Point2D, n)
+	for i := range s.points {
+		s.points[i] = &Point2D{}
+		s.points[i].decode(r)
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (s Scatter2D) MarshalBinary() ([]byte, error) {
+	return marshal(binScatter2D, &s)
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (s *Scatter2D) UnmarshalBinary(data []byte) error {
+	var o Scatter2D
+	err := unmarshal(data, binScatter2D, &o)
+	if err == nil {
+		*s = o
+	}
+	return err
+}
+
+// GobEncode implements gob.GobEncoder
+func (s Scatter2D) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder
+func (s *Scatter2D) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
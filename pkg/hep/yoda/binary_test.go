@@ -0,0 +1,111 @@
+package yoda
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// the types are encoded as values as well as pointers
+var _ = []encoding.BinaryMarshaler{
+	dbn1d{}, dbn2d{}, Bin1D{}, pbin1d{}, Bin2D{}, Axis1D{}, Axis2D{},
+	Histo1D{}, Histo2D{}, Profile1D{}, Point2D{}, Scatter2D{},
+}
+
+var _ = []gob.GobEncoder{
+	dbn1d{}, dbn2d{}, Bin1D{}, pbin1d{}, Bin2D{}, Axis1D{}, Axis2D{},
+	Histo1D{}, Histo2D{}, Profile1D{}, Point2D{}, Scatter2D{},
+}
+
+func TestHisto1DBinaryRoundTrip(t *testing.T) {
+	h := newTestHisto1D(t)
+	data, err := h.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var o Histo1D
+	err = o.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&o, h) {
+		t.Errorf("binary round-trip:\ngot:  %+v\nwant: %+v", &o, h)
+	}
+}
+
+func TestHisto1DGobValue(t *testing.T) {
+	// a histogram held by value in a struct
+	type record struct {
+		Name  string
+		Histo Histo1D
+	}
+	h := newTestHisto1D(t)
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(record{Name: "h", Histo: *h})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var o record
+	err = gob.NewDecoder(&buf).Decode(&o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Name != "h" || !reflect.DeepEqual(&o.Histo, h) {
+		t.Errorf("gob round-trip:\ngot:  %+v\nwant: %+v", &o.Histo, h)
+	}
+}
+
+func TestAxisUnmarshalBinaryInvalid(t *testing.T) {
+	a1 := newTestAxis1D(t, 0, 1, 2, 3)
+	want1 := *a1
+	data, err := a1.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a2, err := NewAxis2D(2, 0, 2, 3, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a2.fill(0.5, 1.5, 2)
+	want2 := *a2
+	data2, err := a2.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		name string
+		data []byte
+	}{
+		{"truncated", data[:len(data)-1]},
+		{"trailing bytes", append(data[:len(data):len(data)], 0)},
+		{"empty", nil},
+	} {
+		err := a1.UnmarshalBinary(c.data)
+		if !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("axis1d %s: got error %v, want %v", c.name, err, ErrInvalidEncoding)
+		}
+		if !reflect.DeepEqual(*a1, want1) {
+			t.Errorf("axis1d %s: axis modified by a failed decoding", c.name)
+		}
+	}
+	for _, c := range []struct {
+		name string
+		data []byte
+	}{
+		{"truncated", data2[:len(data2)-1]},
+		{"trailing bytes", append(data2[:len(data2):len(data2)], 0)},
+		{"wrong type", data},
+	} {
+		err := a2.UnmarshalBinary(c.data)
+		if !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("axis2d %s: got error %v, want %v", c.name, err, ErrInvalidEncoding)
+		}
+		if !reflect.DeepEqual(*a2, want2) {
+			t.Errorf("axis2d %s: axis modified by a failed decoding", c.name)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package yoda
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// WriteFLAT writes the analysis objects to 'w' in the FLAT text format
+// consumed by make-plots.
+// Histo1D objects are first converted with NewScatter2DFromHisto1D. Each
+// point is written as a 'xlow xhigh val errminus errplus' row, where
+// xlow and xhigh span the x-errors of the point.
+func WriteFLAT(w io.Writer, objs ...Object) error {
+	bw := bufio.NewWriter(w)
+	for _, o := range objs {
+		var s *Scatter2D
+		switch o := o.(type) {
+		case *Histo1D:
+			s = NewScatter2DFromHisto1D(o)
+		case *Scatter2D:
+			s = o
+		default:
+			return fmt.Errorf("yoda: cannot write a %s in FLAT format", o.Type())
+		}
+		err := writeYODAHeader(bw, o, "HISTO1D")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(bw, "# xlow\t xhigh\t val\t errminus\t errplus\n")
+		for _, p := range s.points {
+			fmt.Fprintf(bw, "%s\t%s\t%s\t%s\t%s\n",
+				yfmt(p.XMin()), yfmt(p.XMax()),
+				yfmt(p.Y()), yfmt(p.YErrMinus()), yfmt(p.YErrPlus()))
+		}
+		fmt.Fprintf(bw, "# END HISTO1D\n\n")
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,65 @@
+package yoda
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWriteFLAT(t *testing.T) {
+	h, err := NewHisto1D(2, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.SetPath("/h")
+	h.SetTitle("title")
+	h.Fill(0.5, 4)
+	s := NewScatter2D(NewPoint2DAsymErr(1, 3, 0.5, 0.25, 1, 2))
+	s.SetPath("/s")
+
+	var buf bytes.Buffer
+	err = WriteFLAT(&buf, h, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `# BEGIN HISTO1D /h
+Path=/h
+Title=title
+Type=Histo1D
+# xlow	 xhigh	 val	 errminus	 errplus
+0e+00	1e+00	4e+00	2e+00	2e+00
+1e+00	2e+00	0e+00	0e+00	0e+00
+# END HISTO1D
+
+# BEGIN HISTO1D /s
+Path=/s
+Type=Scatter2D
+# xlow	 xhigh	 val	 errminus	 errplus
+5e-01	1.25e+00	3e+00	1e+00	2e+00
+# END HISTO1D
+
+`
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestWriteFLATErrors(t *testing.T) {
+	p, err := NewProfile1D(1, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = WriteFLAT(&buf, p)
+	if err == nil || !strings.Contains(err.Error(), "FLAT") {
+		t.Errorf("writing a profile: got error %v", err)
+	}
+
+	s := NewScatter2D()
+	s.SetAnnotation("bad key", 1)
+	err = WriteFLAT(&buf, s)
+	if !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("invalid annotation: got error %v, want %v", err, ErrInvalidEncoding)
+	}
+}
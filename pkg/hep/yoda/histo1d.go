@@ -1,7 +1,7 @@
 package yoda
 
 import (
-	"errors"
+	"fmt"
 	"math"
 )
 
@@ -56,11 +56,20 @@ func hbin1d_add(a, b *hbin1d) *hbin1d {
 func hbin1d_iadd(a, b *hbin1d) error {
 	if a.Bin1D.edges[0] != b.Bin1D.edges[0] ||
 		a.Bin1D.edges[1] != b.Bin1D.edges[1] {
-		return errors.New("iadd: axes' edges to not match")
+		return fmt.Errorf("iadd: bins' edges do not match: %w", ErrBinningMismatch)
 	}
 	return dbn1d_iadd(&a.Bin1D.xdbn, &b.Bin1D.xdbn)
 }
 
+// in-place subtraction of the input bins: a -= b
+func hbin1d_isub(a, b *hbin1d) error {
+	if a.Bin1D.edges[0] != b.Bin1D.edges[0] ||
+		a.Bin1D.edges[1] != b.Bin1D.edges[1] {
+		return fmt.Errorf("isub: bins' edges do not match: %w", ErrBinningMismatch)
+	}
+	return dbn1d_isub(&a.Bin1D.xdbn, &b.Bin1D.xdbn)
+}
+
 // Returns a new bin, the subtraction of the input bins
 func hbin1d_sub(a, b *hbin1d) *hbin1d {
 	if a.Bin1D.edges[0] != b.Bin1D.edges[0] ||
@@ -92,5 +101,377 @@ func (s sorted_hbin1ds) Swap(i, j int) {
 }
 // A one-dimensional histogram
 type Histo1D struct {
+	obj_impl
+
 	axis Axis1D
 }
+
+// Create a new Histo1D with 'nbins' bins equally spaced between 'lower'
+// and 'upper'
+func NewHisto1D(nbins int, lower, upper float64) (*Histo1D, error) {
+	a, err := NewAxis1D(nbins, lower, upper)
+	if err != nil {
+		return nil, err
+	}
+	return &Histo1D{axis: *a}, nil
+}
+
+// Create a new Histo1D from a list of bin edges
+func NewHisto1DFromEdges(edges []float64) (*Histo1D, error) {
+	a, err := NewAxis1DFromEdges(edges)
+	if err != nil {
+		return nil, err
+	}
+	return &Histo1D{axis: *a}, nil
+}
+
+// Create a new Histo1D with 'nbins' bins between 'lower' and 'upper',
+// equally spaced in log(x)
+func NewHisto1DLog(nbins int, lower, upper float64) (*Histo1D, error) {
+	a, err := NewAxis1DLog(nbins, lower, upper)
+	if err != nil {
+		return nil, err
+	}
+	return &Histo1D{axis: *a}, nil
+}
+
+// Create a new Histo1D with 'nbins' bins between 'lower' and 'upper',
+// equally spaced in x^(1/power)
+func NewHisto1DPow(nbins int, lower, upper, power float64) (*Histo1D, error) {
+	a, err := NewAxis1DPow(nbins, lower, upper, power)
+	if err != nil {
+		return nil, err
+	}
+	return &Histo1D{axis: *a}, nil
+}
+
+// Create a new Histo1D with (at most) 'nbins' bins holding the same number
+// of values of 'sample'. The histogram is not filled.
+func NewHisto1DFromQuantiles(sample []float64, nbins int) (*Histo1D, error) {
+	a, err := NewAxis1DFromQuantiles(sample, nbins)
+	if err != nil {
+		return nil, err
+	}
+	return &Histo1D{axis: *a}, nil
+}
+
+// Type returns the type name of this analysis object
+func (h *Histo1D) Type() string {
+	return "Histo1D"
+}
+
+// Returns the axis of this histogram
+func (h *Histo1D) Axis() *Axis1D {
+	return &h.axis
+}
+
+// Returns the number of bins (not counting under|over-flows)
+func (h *Histo1D) NumBins() uint64 {
+	return h.axis.NumBins()
+}
+
+// Returns the bins of this histogram
+func (h *Histo1D) Bins() []hbin1d {
+	return h.axis.Bins()
+}
+
+// Returns the bin at bin number 'id'
+func (h *Histo1D) Bin(id int) *hbin1d {
+	return h.axis.Bin(id)
+}
+
+// Returns the bin at coordinate 'x', or nil if 'x' is out of range
+func (h *Histo1D) BinByCoord(x float64) *hbin1d {
+	return h.axis.BinByCoord(x)
+}
+
+// Returns the low edge of the histogram
+func (h *Histo1D) LowEdge() float64 {
+	return h.axis.LowEdge()
+}
+
+// Returns the high edge of the histogram
+func (h *Histo1D) HighEdge() float64 {
+	return h.axis.HighEdge()
+}
+
+// Returns the distribution of fills below the low edge
+func (h *Histo1D) Underflow() Bin {
+	return &h.axis.underflow
+}
+
+// Returns the distribution of fills at or above the high edge
+func (h *Histo1D) Overflow() Bin {
+	return &h.axis.overflow
+}
+
+// Fill the histogram with weight 'weight' at position 'x'
+func (h *Histo1D) Fill(x, weight float64) {
+	h.axis.fill(x, weight)
+}
+
+// Fill the bin number 'id' with weight 'weight'
+func (h *Histo1D) FillBin(id int, weight float64) {
+	h.axis.fillBin(id, weight)
+}
+
+// Merge groups of 'n' consecutive bins
+func (h *Histo1D) Rebin(n int) error {
+	return h.axis.Rebin(n)
+}
+
+// Merge the bins 'from' to 'to' (inclusive) into a single bin
+func (h *Histo1D) MergeBins(from, to int) error {
+	return h.axis.MergeBins(from, to)
+}
+
+// Change the binning of the histogram to the given edges, which must
+// coincide with existing ones
+func (h *Histo1D) RebinTo(edges []float64) error {
+	return h.axis.RebinTo(edges)
+}
+
+// Reset the histogram content, keeping the binning
+func (h *Histo1D) Reset() {
+	h.axis.Reset()
+}
+
+// Scale the weights of all bins (and under|over-flows) by 'scale'
+func (h *Histo1D) ScaleW(scale float64) {
+	h.axis.ScaleW(scale)
+}
+
+// Returns the total number of fills, including under|over-flows
+func (h *Histo1D) NumEntries() uint64 {
+	return h.axis.dbn.nfills
+}
+
+// Returns the effective number of entries, i.e. sum(w)**2 / sum(w**2),
+// including under|over-flows
+func (h *Histo1D) EffNumEntries() float64 {
+	return h.axis.dbn.effNumEntries()
+}
+
+// Returns the sum of weights, including under|over-flows
+func (h *Histo1D) SumW() float64 {
+	return h.axis.dbn.sumw
+}
+
+// Returns the sum of weights squared, including under|over-flows
+func (h *Histo1D) SumW2() float64 {
+	return h.axis.dbn.sumw2
+}
+
+// Returns the integral of the histogram, i.e. the sum of weights of all
+// fills. If 'overflows' is false, the under|over-flows are excluded.
+func (h *Histo1D) Integral(overflows bool) float64 {
+	if overflows {
+		return h.axis.dbn.sumw
+	}
+	sumw := 0.0
+	for i := range h.axis.bins {
+		sumw += h.axis.bins[i].SumW()
+	}
+	return sumw
+}
+
+// Returns the mean x-value of all fills, including under|over-flows
+func (h *Histo1D) Mean() (float64, error) {
+	return h.axis.dbn.mean()
+}
+
+// Returns the variance of the x-values of all fills, including
+// under|over-flows
+func (h *Histo1D) Variance() (float64, error) {
+	return h.axis.dbn.variance()
+}
+
+// Returns the standard deviation of the x-values of all fills, including
+// under|over-flows
+func (h *Histo1D) StdDev() (float64, error) {
+	return h.axis.dbn.stdDev()
+}
+
+// Returns the standard error on the mean, including under|over-flows
+func (h *Histo1D) StdErr() (float64, error) {
+	return h.axis.dbn.stdErr()
+}
+
+// Returns a deep copy of the histogram
+func (h *Histo1D) Clone() *Histo1D {
+	o := &Histo1D{axis: *h.axis.Clone()}
+	for k, v := range h.ann {
+		o.SetAnnotation(k, v)
+	}
+	return o
+}
+
+// checkBinning returns ErrBinningMismatch if the 2 histograms do not have
+// the same binning
+func checkBinning(a, b *Histo1D) error {
+	if !Axis1D_SameBinning(&a.axis, &b.axis) {
+		return fmt.Errorf("histograms with %d and %d bins over [%v, %v) and [%v, %v): %w",
+			a.NumBins(), b.NumBins(),
+			a.LowEdge(), a.HighEdge(), b.LowEdge(), b.HighEdge(),
+			ErrBinningMismatch)
+	}
+	return nil
+}
+
+// Returns a new histogram, the sum of the input histograms.
+// The annotations of 'a' are copied over.
+func Histo1D_Add(a, b *Histo1D) (*Histo1D, error) {
+	err := checkBinning(a, b)
+	if err != nil {
+		return nil, err
+	}
+	o := a.Clone()
+	for i := range o.axis.bins {
+		dbn1d_iadd(&o.axis.bins[i].xdbn, &b.axis.bins[i].xdbn)
+	}
+	dbn1d_iadd(&o.axis.underflow, &b.axis.underflow)
+	dbn1d_iadd(&o.axis.overflow, &b.axis.overflow)
+	dbn1d_iadd(&o.axis.dbn, &b.axis.dbn)
+	return o, nil
+}
+
+// Returns a new histogram, the subtraction of the input histograms.
+// The errors are propagated in quadrature: the sums of squared weights are
+// added. The annotations of 'a' are copied over.
+func Histo1D_Subtract(a, b *Histo1D) (*Histo1D, error) {
+	err := checkBinning(a, b)
+	if err != nil {
+		return nil, err
+	}
+	o := a.Clone()
+	for i := range o.axis.bins {
+		dbn1d_isub(&o.axis.bins[i].xdbn, &b.axis.bins[i].xdbn)
+	}
+	dbn1d_isub(&o.axis.underflow, &b.axis.underflow)
+	dbn1d_isub(&o.axis.overflow, &b.axis.overflow)
+	dbn1d_isub(&o.axis.dbn, &b.axis.dbn)
+	return o, nil
+}
+
+// Returns the ratio of the input histograms, bin by bin, as a scatter.
+// The relative errors on the bin heights (sqrt(sum(w**2))/sum(w)) are
+// added in quadrature. Bins with no weight in 'b' yield NaN y-values.
+func Histo1D_Divide(a, b *Histo1D) (*Scatter2D, error) {
+	return combineHisto1Ds(a, b, func(ya, yb float64) float64 {
+		if yb == 0 {
+			return math.NaN()
+		}
+		return ya / yb
+	})
+}
+
+// Returns the product of the input histograms' heights, bin by bin, as a
+// scatter. The relative errors on the bin heights are added in quadrature.
+func Histo1D_Multiply(a, b *Histo1D) (*Scatter2D, error) {
+	return combineHisto1Ds(a, b, func(ya, yb float64) float64 { return ya * yb })
+}
+
+// combineHisto1Ds applies the binary operation 'op' on the heights of the
+// bins of 'a' and 'b', propagating the relative errors in quadrature.
+// Bins where 'op' is not finite yield NaN y-values.
+func combineHisto1Ds(a, b *Histo1D, op func(ya, yb float64) float64) (*Scatter2D, error) {
+	err := checkBinning(a, b)
+	if err != nil {
+		return nil, err
+	}
+	relerr := func(d *dbn1d) float64 {
+		if d.sumw == 0 {
+			return 0.0
+		}
+		return math.Sqrt(d.sumw2) / math.Abs(d.sumw)
+	}
+	s := &Scatter2D{points: make([]*Point2D, 0, len(a.axis.bins))}
+	for i := range a.axis.bins {
+		ba := &a.axis.bins[i]
+		bb := &b.axis.bins[i]
+		x := ba.MidPoint()
+		ex := 0.5 * ba.Width()
+		y := op(ba.height(), bb.height())
+		if math.IsNaN(y) || math.IsInf(y, 0) {
+			s.points = append(s.points, NewPoint2DErr(x, math.NaN(), ex, 0))
+			continue
+		}
+		ra := relerr(&ba.xdbn)
+		rb := relerr(&bb.xdbn)
+		ey := math.Abs(y) * math.Sqrt(ra*ra+rb*rb)
+		s.points = append(s.points, NewPoint2DErr(x, y, ex, ey))
+	}
+	return s, nil
+}
+
+// Returns the efficiency 'pass/total', bin by bin, as a scatter.
+// The errors are binomial, generalized to weighted fills:
+//  err = sqrt(|(1-2eff) sum(w_pass**2) + eff**2 sum(w_total**2)|) / sum(w_total)
+// Bins with no weight in 'total' yield NaN y-values.
+func Efficiency(pass, total *Histo1D) (*Scatter2D, error) {
+	err := checkBinning(pass, total)
+	if err != nil {
+		return nil, err
+	}
+	s := &Scatter2D{points: make([]*Point2D, 0, len(pass.axis.bins))}
+	for i := range pass.axis.bins {
+		bp := &pass.axis.bins[i].xdbn
+		bt := &total.axis.bins[i].xdbn
+		x := pass.axis.bins[i].MidPoint()
+		ex := 0.5 * pass.axis.bins[i].Width()
+		if bt.sumw == 0 {
+			s.points = append(s.points, NewPoint2DErr(x, math.NaN(), ex, 0))
+			continue
+		}
+		if bp.sumw > bt.sumw {
+			return nil, fmt.Errorf("bin %d: pass (%v) > total (%v): %w",
+				i, bp.sumw, bt.sumw, ErrInvalidEfficiency)
+		}
+		eff := bp.sumw / bt.sumw
+		ey := math.Sqrt(math.Abs((1-2*eff)*bp.sumw2+eff*eff*bt.sumw2)) / bt.sumw
+		s.points = append(s.points, NewPoint2DErr(x, eff, ex, ey))
+	}
+	return s, nil
+}
+
+// Returns the efficiency 'pass/total', bin by bin, as a scatter with
+// Clopper-Pearson confidence intervals at confidence level 'cl' (e.g.
+// 0.683) as asymmetric y-errors.
+// The sums of weights are used as event counts.
+// Bins with no weight in 'total' yield NaN y-values.
+func EfficiencyClopperPearson(pass, total *Histo1D, cl float64) (*Scatter2D, error) {
+	err := checkBinning(pass, total)
+	if err != nil {
+		return nil, err
+	}
+	if !(cl > 0 && cl < 1) {
+		return nil, fmt.Errorf("confidence level %v not in (0, 1): %w", cl, ErrInvalidRange)
+	}
+	alpha := 0.5 * (1 - cl)
+	s := &Scatter2D{points: make([]*Point2D, 0, len(pass.axis.bins))}
+	for i := range pass.axis.bins {
+		k := pass.axis.bins[i].xdbn.sumw
+		n := total.axis.bins[i].xdbn.sumw
+		x := pass.axis.bins[i].MidPoint()
+		ex := 0.5 * pass.axis.bins[i].Width()
+		if n == 0 {
+			s.points = append(s.points, NewPoint2DErr(x, math.NaN(), ex, 0))
+			continue
+		}
+		if k > n {
+			return nil, fmt.Errorf("bin %d: pass (%v) > total (%v): %w",
+				i, k, n, ErrInvalidEfficiency)
+		}
+		eff := k / n
+		lo := 0.0
+		if k > 0 {
+			lo = betaIncInv(k, n-k+1, alpha)
+		}
+		hi := 1.0
+		if k < n {
+			hi = betaIncInv(k+1, n-k, 1-alpha)
+		}
+		s.points = append(s.points, NewPoint2DAsymErr(x, eff, ex, ex, eff-lo, hi-eff))
+	}
+	return s, nil
+}
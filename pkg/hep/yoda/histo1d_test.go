@@ -0,0 +1,288 @@
+package yoda
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHisto1DScaleW(t *testing.T) {
+	h, err := NewHisto1D(10, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		x := float64(i%13) - 1
+		h.Fill(x, 0.5+float64(i%3))
+	}
+	mean, err := h.Mean()
+	if err != nil {
+		t.Fatal(err)
+	}
+	variance, err := h.Variance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sumw := h.SumW()
+	sumw2 := h.SumW2()
+
+	const factor = 2.5
+	h.ScaleW(factor)
+
+	check := func(name string, got, want float64) {
+		if math.Abs(got-want) > 1e-12*math.Abs(want) {
+			t.Errorf("%s after ScaleW(%v): got %v, want %v", name, factor, got, want)
+		}
+	}
+	check("SumW", h.SumW(), factor*sumw)
+	check("SumW2", h.SumW2(), factor*factor*sumw2)
+	got, err := h.Mean()
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("Mean", got, mean)
+	got, err = h.Variance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("Variance", got, variance)
+}
+
+func TestHisto1DAddSubtract(t *testing.T) {
+	a, err := NewHisto1D(2, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.SetAnnotation("Title", "a")
+	a.Fill(0.5, 2)
+	a.Fill(1.5, 3)
+	b, err := NewHisto1D(2, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Fill(0.5, 1)
+	b.Fill(2.5, 4)
+
+	type want struct {
+		sumw, sumw2 float64
+	}
+	check := func(op string, h *Histo1D, bins []want, overflow want, entries uint64) {
+		for i, w := range bins {
+			bin := h.Bin(i)
+			if bin.SumW() != w.sumw || bin.SumW2() != w.sumw2 {
+				t.Errorf("%s bin %d: got (%v, %v), want (%v, %v)",
+					op, i, bin.SumW(), bin.SumW2(), w.sumw, w.sumw2)
+			}
+		}
+		o := &h.axis.overflow
+		if o.sumw != overflow.sumw || o.sumw2 != overflow.sumw2 {
+			t.Errorf("%s overflow: got (%v, %v), want (%v, %v)",
+				op, o.sumw, o.sumw2, overflow.sumw, overflow.sumw2)
+		}
+		if h.NumEntries() != entries {
+			t.Errorf("%s: got %d entries, want %d", op, h.NumEntries(), entries)
+		}
+		if h.Title() != "a" {
+			t.Errorf("%s: got title %q, want %q", op, h.Title(), "a")
+		}
+	}
+
+	sum, err := Histo1D_Add(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("add", sum, []want{{3, 5}, {3, 9}}, want{4, 16}, 4)
+
+	diff, err := Histo1D_Subtract(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("subtract", diff, []want{{1, 5}, {3, 9}}, want{-4, 16}, 0)
+
+	if a.SumW() != 5 || b.SumW() != 5 {
+		t.Errorf("inputs modified: got sums of weights %v and %v", a.SumW(), b.SumW())
+	}
+}
+
+func TestHisto1DDivideMultiply(t *testing.T) {
+	// bin 0 is empty in 'b', bin 1 is empty in 'a'
+	a, err := NewHisto1D(3, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Fill(0.5, 2)
+	a.Fill(2.5, 2)
+	a.Fill(2.5, 2)
+	b, err := NewHisto1D(3, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Fill(1.5, 4)
+	b.Fill(2.5, 1)
+
+	// relative errors sqrt(8)/4 and 1 added in quadrature
+	ey := 4 * math.Sqrt(1.5)
+	for _, c := range []struct {
+		name string
+		op   func(a, b *Histo1D) (*Scatter2D, error)
+		want []float64
+	}{
+		{"divide", Histo1D_Divide, []float64{math.NaN(), 0, 4}},
+		{"multiply", Histo1D_Multiply, []float64{0, 0, 4}},
+	} {
+		s, err := c.op(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.NumPoints() != 3 {
+			t.Fatalf("%s: got %d points, want 3", c.name, s.NumPoints())
+		}
+		for i, y := range c.want {
+			p := s.Point(i)
+			if p.X() != float64(i)+0.5 || p.XErrAvg() != 0.5 {
+				t.Errorf("%s point %d: got x=%v+-%v", c.name, i, p.X(), p.XErrAvg())
+			}
+			if !sameFloat(p.Y(), y) {
+				t.Errorf("%s point %d: got y=%v, want %v", c.name, i, p.Y(), y)
+			}
+		}
+		if p := s.Point(1); p.YErrAvg() != 0 {
+			t.Errorf("%s point 1: got y error %v, want 0", c.name, p.YErrAvg())
+		}
+		if p := s.Point(2); math.Abs(p.YErrAvg()-ey) > 1e-12 {
+			t.Errorf("%s point 2: got y error %v, want %v", c.name, p.YErrAvg(), ey)
+		}
+	}
+
+	c, err := NewHisto1D(3, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Histo1D_Divide(a, c); !errors.Is(err, ErrBinningMismatch) {
+		t.Errorf("divide: got error %v, want %v", err, ErrBinningMismatch)
+	}
+}
+
+// newTestEfficiency returns the histograms of 'pass' and 'total' unit-weight
+// fills per bin
+func newTestEfficiency(t *testing.T, pass, total []int) (*Histo1D, *Histo1D) {
+	hp, err := NewHisto1D(len(pass), 0, float64(len(pass)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ht, err := NewHisto1D(len(total), 0, float64(len(total)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range pass {
+		for j := 0; j < pass[i]; j++ {
+			hp.FillBin(i, 1)
+		}
+		for j := 0; j < total[i]; j++ {
+			ht.FillBin(i, 1)
+		}
+	}
+	return hp, ht
+}
+
+func TestEfficiency(t *testing.T) {
+	pass, total := newTestEfficiency(t, []int{1, 0, 4, 0}, []int{4, 2, 4, 0})
+	s, err := Efficiency(pass, total)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// unit weights give back sqrt(eff*(1-eff)/n)
+	for i, want := range []struct{ y, ey float64 }{
+		{0.25, math.Sqrt(0.25 * 0.75 / 4)},
+		{0, 0},
+		{1, 0},
+		{math.NaN(), 0},
+	} {
+		p := s.Point(i)
+		if !sameFloat(p.Y(), want.y) || math.Abs(p.YErrAvg()-want.ey) > 1e-12 {
+			t.Errorf("point %d: got %v+-%v, want %v+-%v", i, p.Y(), p.YErrAvg(), want.y, want.ey)
+		}
+	}
+
+	if _, err := Efficiency(total, pass); !errors.Is(err, ErrInvalidEfficiency) {
+		t.Errorf("pass > total: got error %v, want %v", err, ErrInvalidEfficiency)
+	}
+	other, err := NewHisto1D(3, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Efficiency(pass, other); !errors.Is(err, ErrBinningMismatch) {
+		t.Errorf("binning: got error %v, want %v", err, ErrBinningMismatch)
+	}
+}
+
+func TestEfficiencyClopperPearson(t *testing.T) {
+	pass, total := newTestEfficiency(t, []int{5, 0, 10, 0}, []int{10, 10, 10, 0})
+	s, err := EfficiencyClopperPearson(pass, total, 0.95)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the bounds for k=0 and k=n have the closed forms 1-(alpha/2)**(1/n)
+	// and (alpha/2)**(1/n)
+	edge := math.Pow(0.025, 0.1)
+	for i, want := range []struct{ y, lo, hi float64 }{
+		{0.5, 0.187086, 0.812914},
+		{0, 0, 1 - edge},
+		{1, edge, 1},
+	} {
+		p := s.Point(i)
+		if p.Y() != want.y ||
+			math.Abs(p.YMin()-want.lo) > 1e-6 ||
+			math.Abs(p.YMax()-want.hi) > 1e-6 {
+			t.Errorf("point %d: got %v [%v, %v], want %v [%v, %v]",
+				i, p.Y(), p.YMin(), p.YMax(), want.y, want.lo, want.hi)
+		}
+	}
+	if p := s.Point(3); !math.IsNaN(p.Y()) {
+		t.Errorf("empty total: got y=%v, want NaN", p.Y())
+	}
+
+	for _, cl := range []float64{0, 1, -0.5, math.NaN()} {
+		if _, err := EfficiencyClopperPearson(pass, total, cl); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("cl=%v: got error %v, want %v", cl, err, ErrInvalidRange)
+		}
+	}
+	if _, err := EfficiencyClopperPearson(total, pass, 0.95); !errors.Is(err, ErrInvalidEfficiency) {
+		t.Errorf("pass > total: got error %v, want %v", err, ErrInvalidEfficiency)
+	}
+}
+
+func benchHisto1DFill(b *testing.B, h *Histo1D) {
+	// a fixed pseudo-random sequence of positions, filled over and over
+	xs := make([]float64, 1<<16)
+	rnd := rand.New(rand.NewSource(1))
+	for i := range xs {
+		xs[i] = h.LowEdge() + rnd.Float64()*(h.HighEdge()-h.LowEdge())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Fill(xs[i&(len(xs)-1)], 1)
+	}
+}
+
+// Fill benchmarks on 10^5 bins. Run at the scale of a large analysis with
+//
+//	go test -bench Histo1DFill -benchtime 100000000x
+func BenchmarkHisto1DFill(b *testing.B) {
+	const nbins = 100000
+	b.Run("uniform", func(b *testing.B) {
+		h, err := NewHisto1D(nbins, 0, 1)
+		if err != nil {
+			b.Fatal(err)
+		}
+		benchHisto1DFill(b, h)
+	})
+	b.Run("non-uniform", func(b *testing.B) {
+		h, err := NewHisto1DLog(nbins, 1, 1e5)
+		if err != nil {
+			b.Fatal(err)
+		}
+		benchHisto1DFill(b, h)
+	})
+}
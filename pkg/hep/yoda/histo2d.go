@@ -0,0 +1,205 @@
+package yoda
+
+// A two-dimensional histogram
+type Histo2D struct {
+	obj_impl
+
+	axis Axis2D
+}
+
+// Create a new Histo2D with 'nx' (resp. 'ny') bins equally spaced between
+// 'xlow' and 'xhigh' (resp. 'ylow' and 'yhigh')
+func NewHisto2D(nx int, xlow, xhigh float64, ny int, ylow, yhigh float64) (*Histo2D, error) {
+	a, err := NewAxis2D(nx, xlow, xhigh, ny, ylow, yhigh)
+	if err != nil {
+		return nil, err
+	}
+	return &Histo2D{axis: *a}, nil
+}
+
+// Create a new Histo2D from lists of bin edges along x and y
+func NewHisto2DFromEdges(xedges, yedges []float64) (*Histo2D, error) {
+	a, err := NewAxis2DFromEdges(xedges, yedges)
+	if err != nil {
+		return nil, err
+	}
+	return &Histo2D{axis: *a}, nil
+}
+
+// Type returns the type name of this analysis object
+func (h *Histo2D) Type() string {
+	return "Histo2D"
+}
+
+// Returns the axis of this histogram
+func (h *Histo2D) Axis() *Axis2D {
+	return &h.axis
+}
+
+// Returns the number of bins (not counting outflows)
+func (h *Histo2D) NumBins() uint64 {
+	return h.axis.NumBins()
+}
+
+// Returns the number of bins along x
+func (h *Histo2D) NumBinsX() uint64 {
+	return h.axis.NumBinsX()
+}
+
+// Returns the number of bins along y
+func (h *Histo2D) NumBinsY() uint64 {
+	return h.axis.NumBinsY()
+}
+
+// Returns the bins of this histogram, x-index running fastest
+func (h *Histo2D) Bins() []Bin2D {
+	return h.axis.Bins()
+}
+
+// Returns the bin at bin numbers ('ix', 'iy')
+func (h *Histo2D) Bin(ix, iy int) *Bin2D {
+	return h.axis.Bin(ix, iy)
+}
+
+// Returns the bin at coordinate ('x', 'y'), or nil if it is out of range
+func (h *Histo2D) BinByCoord(x, y float64) *Bin2D {
+	return h.axis.BinByCoord(x, y)
+}
+
+// Returns the outflow distribution for the region ('ix', 'iy'), where
+// -1 means below the range, 0 within and +1 above, along each axis.
+func (h *Histo2D) Outflow(ix, iy int) (Bin, error) {
+	d, err := h.axis.Outflow(ix, iy)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Fill the histogram with weight 'weight' at position ('x', 'y')
+func (h *Histo2D) Fill(x, y, weight float64) {
+	h.axis.fill(x, y, weight)
+}
+
+// Fill the bin ('ix', 'iy') with weight 'weight'
+func (h *Histo2D) FillBin(ix, iy int, weight float64) {
+	h.axis.fillBin(ix, iy, weight)
+}
+
+// Reset the histogram content, keeping the binning
+func (h *Histo2D) Reset() {
+	h.axis.Reset()
+}
+
+// Scale the weights of all bins (and outflows) by 'scale'
+func (h *Histo2D) ScaleW(scale float64) {
+	h.axis.ScaleW(scale)
+}
+
+// Returns the total number of fills, including outflows
+func (h *Histo2D) NumEntries() uint64 {
+	return h.axis.dbn.nfills
+}
+
+// Returns the effective number of entries, i.e. sum(w)**2 / sum(w**2),
+// including outflows
+func (h *Histo2D) EffNumEntries() float64 {
+	return h.axis.dbn.effNumEntries()
+}
+
+// Returns the sum of weights, including outflows
+func (h *Histo2D) SumW() float64 {
+	return h.axis.dbn.sumw
+}
+
+// Returns the sum of weights squared, including outflows
+func (h *Histo2D) SumW2() float64 {
+	return h.axis.dbn.sumw2
+}
+
+// Returns the integral of the histogram, i.e. the sum of weights of all
+// fills. If 'overflows' is false, the outflows are excluded.
+func (h *Histo2D) Integral(overflows bool) float64 {
+	if overflows {
+		return h.axis.dbn.sumw
+	}
+	sumw := 0.0
+	for i := range h.axis.bins {
+		sumw += h.axis.bins[i].SumW()
+	}
+	return sumw
+}
+
+// Returns the mean x-value of all fills, including outflows
+func (h *Histo2D) XMean() (float64, error) {
+	return h.axis.dbn.xMean()
+}
+
+// Returns the mean y-value of all fills, including outflows
+func (h *Histo2D) YMean() (float64, error) {
+	return h.axis.dbn.yMean()
+}
+
+// Returns the variance of the x-values of all fills, including outflows
+func (h *Histo2D) XVariance() (float64, error) {
+	d := h.axis.dbn.xdbn()
+	return d.variance()
+}
+
+// Returns the variance of the y-values of all fills, including outflows
+func (h *Histo2D) YVariance() (float64, error) {
+	d := h.axis.dbn.ydbn()
+	return d.variance()
+}
+
+// Returns the standard deviation of the x-values of all fills, including
+// outflows
+func (h *Histo2D) XStdDev() (float64, error) {
+	d := h.axis.dbn.xdbn()
+	return d.stdDev()
+}
+
+// Returns the standard deviation of the y-values of all fills, including
+// outflows
+func (h *Histo2D) YStdDev() (float64, error) {
+	d := h.axis.dbn.ydbn()
+	return d.stdDev()
+}
+
+// Returns the standard error on the x-mean, including outflows
+func (h *Histo2D) XStdErr() (float64, error) {
+	d := h.axis.dbn.xdbn()
+	return d.stdErr()
+}
+
+// Returns the standard error on the y-mean, including outflows
+func (h *Histo2D) YStdErr() (float64, error) {
+	d := h.axis.dbn.ydbn()
+	return d.stdErr()
+}
+
+// Returns the covariance of the x- and y-values of all fills, including
+// outflows
+func (h *Histo2D) Covariance() (float64, error) {
+	return h.axis.dbn.covariance()
+}
+
+// Returns the correlation of the x- and y-values of all fills, including
+// outflows
+func (h *Histo2D) Correlation() (float64, error) {
+	return h.axis.dbn.correlation()
+}
+
+// Returns the projection of this histogram onto the x-axis, summing over
+// the in-range y-bins. The under|over-flows of the projection are the
+// outflows below|above the x-range and within the y-range.
+func (h *Histo2D) ProjectionX() *Histo1D {
+	return &Histo1D{axis: *h.axis.project(false)}
+}
+
+// Returns the projection of this histogram onto the y-axis, summing over
+// the in-range x-bins. The under|over-flows of the projection are the
+// outflows below|above the y-range and within the x-range.
+func (h *Histo2D) ProjectionY() *Histo1D {
+	return &Histo1D{axis: *h.axis.project(true)}
+}
@@ -0,0 +1,126 @@
+package yoda
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestHisto2DProjection(t *testing.T) {
+	h, err := NewHisto2D(3, 0, 3, 2, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range []struct{ x, y, w float64 }{
+		{0.5, 0.5, 1},
+		{0.5, 1.5, 2},
+		{1.5, 1.5, 3},
+		{2.5, 0.5, 4},
+		{-1, 0.5, 5}, // below x, within y
+		{4, 1.5, 6},  // above x, within y
+		{1.5, -1, 7}, // within x, below y
+		{1.5, 3, 8},  // within x, above y
+		{-1, -1, 9},  // below x and y: in neither projection
+	} {
+		h.Fill(f.x, f.y, f.w)
+	}
+
+	px := h.ProjectionX()
+	for i, want := range []float64{3, 3, 4} {
+		if w := px.Bin(i).SumW(); w != want {
+			t.Errorf("x-projection bin %d: got sumw=%v, want %v", i, w, want)
+		}
+	}
+	if w := px.Underflow().SumW(); w != 5 {
+		t.Errorf("x-projection underflow: got sumw=%v, want 5", w)
+	}
+	if w := px.Overflow().SumW(); w != 6 {
+		t.Errorf("x-projection overflow: got sumw=%v, want 6", w)
+	}
+	if w := px.SumW(); w != 21 {
+		t.Errorf("x-projection: got sumw=%v, want 21", w)
+	}
+	if x, err := px.Bin(0).XMean(); err != nil || x != 0.5 {
+		t.Errorf("x-projection bin 0: got x-mean %v (err=%v), want 0.5", x, err)
+	}
+
+	py := h.ProjectionY()
+	for i, want := range []float64{5, 5} {
+		if w := py.Bin(i).SumW(); w != want {
+			t.Errorf("y-projection bin %d: got sumw=%v, want %v", i, w, want)
+		}
+	}
+	if w := py.Underflow().SumW(); w != 7 {
+		t.Errorf("y-projection underflow: got sumw=%v, want 7", w)
+	}
+	if w := py.Overflow().SumW(); w != 8 {
+		t.Errorf("y-projection overflow: got sumw=%v, want 8", w)
+	}
+	if x, err := py.Bin(1).XMean(); err != nil || x != 1.5 {
+		t.Errorf("y-projection bin 1: got mean %v (err=%v), want 1.5", x, err)
+	}
+}
+
+func TestHisto2DCovariance(t *testing.T) {
+	h, err := NewHisto2D(10, 0, 10, 10, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xs := []float64{1, 2, 3, 4, 5}
+	ys := []float64{2, 4, 5, 4, 5}
+	for i := range xs {
+		h.Fill(xs[i], ys[i], 1)
+	}
+	// sample covariance and Pearson correlation of the unweighted fills
+	mx, my := 3.0, 4.0
+	var sxy, sxx, syy float64
+	for i := range xs {
+		sxy += (xs[i] - mx) * (ys[i] - my)
+		sxx += (xs[i] - mx) * (xs[i] - mx)
+		syy += (ys[i] - my) * (ys[i] - my)
+	}
+	n := float64(len(xs))
+
+	cov, err := h.Covariance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := sxy / (n - 1); math.Abs(cov-want) > 1e-12 {
+		t.Errorf("got covariance %v, want %v", cov, want)
+	}
+	corr, err := h.Correlation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := sxy / math.Sqrt(sxx*syy); math.Abs(corr-want) > 1e-12 {
+		t.Errorf("got correlation %v, want %v", corr, want)
+	}
+
+	// a line is fully (anti-)correlated
+	line, err := NewHisto2D(10, 0, 10, 10, -10, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testFills(5, func(i int, w float64) {
+		line.Fill(float64(i), 3-2*float64(i), w)
+	})
+	corr, err = line.Correlation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(corr+1) > 1e-12 {
+		t.Errorf("line: got correlation %v, want -1", corr)
+	}
+
+	empty, err := NewHisto2D(1, 0, 1, 1, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := empty.Covariance(); !errors.Is(err, ErrLowStats) {
+		t.Errorf("empty: got error %v, want %v", err, ErrLowStats)
+	}
+	empty.Fill(0.5, 0.5, 1)
+	if _, err := empty.Correlation(); !errors.Is(err, ErrLowStats) {
+		t.Errorf("single entry: got error %v, want %v", err, ErrLowStats)
+	}
+}
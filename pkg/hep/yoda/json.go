@@ -0,0 +1,750 @@
+package yoda
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// JSON encoding of the yoda types.
+//
+// The analysis objects are encoded as JSON objects holding their type, the
+// version of the schema (jsonVersion), their annotations and their content:
+//
+//  Histo1D:
+//   {"type": "Histo1D", "version": 1, "annotations": {"Path": "/h", ...},
+//    "edges": [0, 1, 2], "uniform": true,
+//    "bins": [dbn1d, dbn1d],
+//    "underflow": dbn1d, "overflow": dbn1d, "total": dbn1d}
+//
+//  Histo2D:
+//   {"type": "Histo2D", "version": 1, "annotations": {...},
+//    "xedges": [...], "yedges": [...], "xuniform": true, "yuniform": true,
+//    "bins": [dbn2d, ...],
+//    "outflows": [dbn2d x 8], "total": dbn2d}
+//
+//  Profile1D:
+//   {"type": "Profile1D", "version": 1, "annotations": {...},
+//    "edges": [...], "uniform": true,
+//    "bins": [pdbn, ...],
+//    "underflow": pdbn, "overflow": pdbn, "total": pdbn}
+//
+//  Scatter2D:
+//   {"type": "Scatter2D", "version": 1, "annotations": {...},
+//    "points": [point, ...]}
+//
+// with
+//
+//  dbn1d: {"nfills": 2, "sumw": 2, "sumw2": 2, "sumwx": 1, "sumwx2": 0.5}
+//  dbn2d: dbn1d, plus "sumwy", "sumwy2" and "sumwxy"
+//  pdbn:  {"x": dbn1d, "y": dbn1d}, the distributions of the x and
+//         y-values
+//  point: {"x": 1, "y": 2, "xerr": [minus, plus], "yerr": [minus, plus]}
+//
+// The bins of a Histo1D or Profile1D are ordered by increasing x. The bins
+// of a Histo2D are ordered by row: bin (ix, iy) is at index iy*nx+ix. The
+// outflows of a Histo2D are ordered as in Axis2D.
+// Axis1D and Axis2D are encoded as the content of a Histo1D (resp.
+// Histo2D), Bin1D and Bin2D as a dbn1d (resp. dbn2d) with their edges
+// ("xlow", "xhigh", and "ylow", "yhigh" for Bin2D) and Point2D as a point.
+//
+// Numbers are written with the shortest representation which round-trips
+// exactly. NaN and infinite values, e.g. the y-values of the empty bins of
+// a divided histogram, are written as the strings "NaN", "+Inf" and "-Inf".
+// Annotations are decoded as strings, booleans, ints (for integral
+// numbers) and float64s.
+
+// version of the JSON schema
+const jsonVersion = 1
+
+// jsonFloat is a float64 whose NaN and infinite values are encoded as
+// strings
+type jsonFloat float64
+
+// MarshalJSON implements json.Marshaler
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	v := float64(f)
+	switch {
+	case math.IsNaN(v):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(v, +1):
+		return []byte(`"+Inf"`), nil
+	case math.IsInf(v, -1):
+		return []byte(`"-Inf"`), nil
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (f *jsonFloat) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '"' {
+		return json.Unmarshal(data, (*float64)(f))
+	}
+	switch string(data) {
+	case `"NaN"`:
+		*f = jsonFloat(math.NaN())
+	case `"+Inf"`:
+		*f = jsonFloat(math.Inf(+1))
+	case `"-Inf"`:
+		*f = jsonFloat(math.Inf(-1))
+	default:
+		return fmt.Errorf("yoda: invalid JSON number %s: %w", data, ErrInvalidEncoding)
+	}
+	return nil
+}
+
+func newJSONFloats(v []float64) []jsonFloat {
+	if v == nil {
+		return nil
+	}
+	o := make([]jsonFloat, len(v))
+	for i, x := range v {
+		o[i] = jsonFloat(x)
+	}
+	return o
+}
+
+func fromJSONFloats(v []jsonFloat) []float64 {
+	if v == nil {
+		return nil
+	}
+	o := make([]float64, len(v))
+	for i, x := range v {
+		o[i] = float64(x)
+	}
+	return o
+}
+
+type jsonDbn1D struct {
+	NFills uint64    `json:"nfills"`
+	SumW   jsonFloat `json:"sumw"`
+	SumW2  jsonFloat `json:"sumw2"`
+	SumWX  jsonFloat `json:"sumwx"`
+	SumWX2 jsonFloat `json:"sumwx2"`
+}
+
+func newJSONDbn1D(d *dbn1d) jsonDbn1D {
+	return jsonDbn1D{
+		NFills: d.nfills,
+		SumW:   jsonFloat(d.sumw),
+		SumW2:  jsonFloat(d.sumw2),
+		SumWX:  jsonFloat(d.sumwx),
+		SumWX2: jsonFloat(d.sumwx2),
+	}
+}
+
+func (j *jsonDbn1D) dbn() dbn1d {
+	return dbn1d{
+		nfills: j.NFills,
+		sumw:   float64(j.SumW),
+		sumw2:  float64(j.SumW2),
+		sumwx:  float64(j.SumWX),
+		sumwx2: float64(j.SumWX2),
+	}
+}
+
+type jsonDbn2D struct {
+	jsonDbn1D
+	SumWY  jsonFloat `json:"sumwy"`
+	SumWY2 jsonFloat `json:"sumwy2"`
+	SumWXY jsonFloat `json:"sumwxy"`
+}
+
+func newJSONDbn2D(d *dbn2d) jsonDbn2D {
+	return jsonDbn2D{
+		jsonDbn1D: jsonDbn1D{
+			NFills: d.nfills,
+			SumW:   jsonFloat(d.sumw),
+			SumW2:  jsonFloat(d.sumw2),
+			SumWX:  jsonFloat(d.sumwx),
+			SumWX2: jsonFloat(d.sumwx2),
+		},
+		SumWY:  jsonFloat(d.sumwy),
+		SumWY2: jsonFloat(d.sumwy2),
+		SumWXY: jsonFloat(d.sumwxy),
+	}
+}
+
+func (j *jsonDbn2D) dbn() dbn2d {
+	return dbn2d{
+		nfills: j.NFills,
+		sumw:   float64(j.SumW),
+		sumw2:  float64(j.SumW2),
+		sumwx:  float64(j.SumWX),
+		sumwx2: float64(j.SumWX2),
+		sumwy:  float64(j.SumWY),
+		sumwy2: float64(j.SumWY2),
+		sumwxy: float64(j.SumWXY),
+	}
+}
+
+type jsonPdbn struct {
+	X jsonDbn1D `json:"x"`
+	Y jsonDbn1D `json:"y"`
+}
+
+func newJSONPdbn(b *pbin1d) jsonPdbn {
+	return jsonPdbn{X: newJSONDbn1D(&b.xdbn), Y: newJSONDbn1D(&b.ydbn)}
+}
+
+type jsonBin1D struct {
+	XLow  jsonFloat `json:"xlow"`
+	XHigh jsonFloat `json:"xhigh"`
+	jsonDbn1D
+}
+
+type jsonBin2D struct {
+	XLow  jsonFloat `json:"xlow"`
+	XHigh jsonFloat `json:"xhigh"`
+	YLow  jsonFloat `json:"ylow"`
+	YHigh jsonFloat `json:"yhigh"`
+	jsonDbn2D
+}
+
+type jsonAxis1D struct {
+	Edges     []jsonFloat `json:"edges"`
+	Uniform   bool        `json:"uniform"`
+	Bins      []jsonDbn1D `json:"bins"`
+	Underflow jsonDbn1D   `json:"underflow"`
+	Overflow  jsonDbn1D   `json:"overflow"`
+	Total     jsonDbn1D   `json:"total"`
+}
+
+type jsonAxis2D struct {
+	XEdges   []jsonFloat  `json:"xedges"`
+	YEdges   []jsonFloat  `json:"yedges"`
+	XUniform bool         `json:"xuniform"`
+	YUniform bool         `json:"yuniform"`
+	Bins     []jsonDbn2D  `json:"bins"`
+	Outflows [8]jsonDbn2D `json:"outflows"`
+	Total    jsonDbn2D    `json:"total"`
+}
+
+type jsonProfile1D struct {
+	Edges     []jsonFloat `json:"edges"`
+	Uniform   bool        `json:"uniform"`
+	Bins      []jsonPdbn  `json:"bins"`
+	Underflow jsonPdbn    `json:"underflow"`
+	Overflow  jsonPdbn    `json:"overflow"`
+	Total     jsonPdbn    `json:"total"`
+}
+
+type jsonPoint2D struct {
+	X    jsonFloat    `json:"x"`
+	Y    jsonFloat    `json:"y"`
+	XErr [2]jsonFloat `json:"xerr"`
+	YErr [2]jsonFloat `json:"yerr"`
+}
+
+// jsonHeader holds the fields common to all analysis objects
+type jsonHeader struct {
+	Type        string          `json:"type"`
+	Version     int             `json:"version"`
+	Annotations json.RawMessage `json:"annotations,omitempty"`
+}
+
+func newJSONHeader(typ string, ann Annotations) (jsonHeader, error) {
+	hdr := jsonHeader{Type: typ, Version: jsonVersion}
+	if len(ann) == 0 {
+		return hdr, nil
+	}
+	raw, err := json.Marshal(ann)
+	if err != nil {
+		return hdr, fmt.Errorf("yoda: cannot encode annotations: %w", err)
+	}
+	hdr.Annotations = raw
+	return hdr, nil
+}
+
+// check checks the header describes an object of type 'typ' and decodes
+// its annotations
+func (hdr *jsonHeader) check(typ string) (Annotations, error) {
+	if hdr.Type != typ {
+		return nil, fmt.Errorf("yoda: cannot decode a %q JSON object into a %s: %w", hdr.Type, typ, ErrInvalidEncoding)
+	}
+	if hdr.Version != jsonVersion {
+		return nil, fmt.Errorf("yoda: unsupported JSON schema version %d: %w", hdr.Version, ErrInvalidEncoding)
+	}
+	if len(hdr.Annotations) == 0 || string(hdr.Annotations) == "null" {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(hdr.Annotations))
+	dec.UseNumber()
+	var raw map[string]interface{}
+	err := dec.Decode(&raw)
+	if err != nil {
+		return nil, fmt.Errorf("yoda: invalid annotations: %v: %w", err, ErrInvalidEncoding)
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	ann := make(Annotations, len(raw))
+	for k, v := range raw {
+		ann[k] = fromJSONNumber(v)
+	}
+	return ann, nil
+}
+
+// fromJSONNumber converts the json.Numbers in 'v' into ints, or float64s
+// if they are not integral
+func fromJSONNumber(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if !strings.ContainsAny(string(v), ".eE") {
+			if i, err := v.Int64(); err == nil && int64(int(i)) == i {
+				return int(i)
+			}
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = fromJSONNumber(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = fromJSONNumber(v[k])
+		}
+	}
+	return v
+}
+
+// checkJSONEdges checks a list of edges is valid
+func checkJSONEdges(edges []float64) error {
+	err := checkEdges(edges)
+	if err != nil {
+		return fmt.Errorf("yoda: invalid JSON edges: %v: %w", err, ErrInvalidEncoding)
+	}
+	return nil
+}
+
+// checkJSONBins checks a list of edges holds 'nbins'+1 valid edges
+func checkJSONBins(edges []float64, nbins int) error {
+	err := checkJSONEdges(edges)
+	if err != nil {
+		return err
+	}
+	if nbins != len(edges)-1 {
+		return fmt.Errorf("yoda: %d JSON bins for %d edges: %w", nbins, len(edges), ErrInvalidEncoding)
+	}
+	return nil
+}
+
+// Axis1D
+
+func newJSONAxis1D(a *Axis1D) jsonAxis1D {
+	j := jsonAxis1D{
+		Edges:     newJSONFloats(a.edges),
+		Uniform:   a.uniform,
+		Bins:      make([]jsonDbn1D, len(a.bins)),
+		Underflow: newJSONDbn1D(&a.underflow),
+		Overflow:  newJSONDbn1D(&a.overflow),
+		Total:     newJSONDbn1D(&a.dbn),
+	}
+	for i := range a.bins {
+		j.Bins[i] = newJSONDbn1D(&a.bins[i].xdbn)
+	}
+	return j
+}
+
+func (j *jsonAxis1D) axis() (*Axis1D, error) {
+	edges := fromJSONFloats(j.Edges)
+	err := checkJSONBins(edges, len(j.Bins))
+	if err != nil {
+		return nil, err
+	}
+	a, err := NewAxis1DFromEdges(edges)
+	if err != nil {
+		return nil, err
+	}
+	a.uniform = j.Uniform
+	for i := range a.bins {
+		a.bins[i].xdbn = j.Bins[i].dbn()
+	}
+	a.underflow = j.Underflow.dbn()
+	a.overflow = j.Overflow.dbn()
+	a.dbn = j.Total.dbn()
+	return a, nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (a Axis1D) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newJSONAxis1D(&a))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (a *Axis1D) UnmarshalJSON(data []byte) error {
+	var j jsonAxis1D
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	o, err := j.axis()
+	if err != nil {
+		return err
+	}
+	*a = *o
+	return nil
+}
+
+// Axis2D
+
+func newJSONAxis2D(a *Axis2D) jsonAxis2D {
+	j := jsonAxis2D{
+		XEdges:   newJSONFloats(a.xedges),
+		YEdges:   newJSONFloats(a.yedges),
+		XUniform: a.xuniform,
+		YUniform: a.yuniform,
+		Bins:     make([]jsonDbn2D, len(a.bins)),
+		Total:    newJSONDbn2D(&a.dbn),
+	}
+	for i := range a.bins {
+		j.Bins[i] = newJSONDbn2D(&a.bins[i].dbn)
+	}
+	for i := range a.outflows {
+		j.Outflows[i] = newJSONDbn2D(&a.outflows[i])
+	}
+	return j
+}
+
+func (j *jsonAxis2D) axis() (*Axis2D, error) {
+	xedges := fromJSONFloats(j.XEdges)
+	yedges := fromJSONFloats(j.YEdges)
+	for _, edges := range [][]float64{xedges, yedges} {
+		err := checkJSONEdges(edges)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if nbins := (len(xedges) - 1) * (len(yedges) - 1); nbins != len(j.Bins) {
+		return nil, fmt.Errorf("yoda: %d JSON bins for %d bins: %w", len(j.Bins), nbins, ErrInvalidEncoding)
+	}
+	a, err := NewAxis2DFromEdges(xedges, yedges)
+	if err != nil {
+		return nil, err
+	}
+	a.xuniform = j.XUniform
+	a.yuniform = j.YUniform
+	for i := range a.bins {
+		a.bins[i].dbn = j.Bins[i].dbn()
+	}
+	for i := range a.outflows {
+		a.outflows[i] = j.Outflows[i].dbn()
+	}
+	a.dbn = j.Total.dbn()
+	return a, nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (a Axis2D) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newJSONAxis2D(&a))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (a *Axis2D) UnmarshalJSON(data []byte) error {
+	var j jsonAxis2D
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	o, err := j.axis()
+	if err != nil {
+		return err
+	}
+	*a = *o
+	return nil
+}
+
+// Bin1D
+
+// MarshalJSON implements json.Marshaler
+func (b Bin1D) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonBin1D{
+		XLow:      jsonFloat(b.edges[0]),
+		XHigh:     jsonFloat(b.edges[1]),
+		jsonDbn1D: newJSONDbn1D(&b.xdbn),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (b *Bin1D) UnmarshalJSON(data []byte) error {
+	var j jsonBin1D
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	*b = Bin1D{edges: [2]float64{float64(j.XLow), float64(j.XHigh)}, xdbn: j.dbn()}
+	return nil
+}
+
+// pbin1d: the methods promoted from Bin1D would drop the y distribution
+
+func (b pbin1d) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		XLow  jsonFloat `json:"xlow"`
+		XHigh jsonFloat `json:"xhigh"`
+		jsonPdbn
+	}{jsonFloat(b.edges[0]), jsonFloat(b.edges[1]), newJSONPdbn(&b)})
+}
+
+func (b *pbin1d) UnmarshalJSON(data []byte) error {
+	var j struct {
+		XLow  jsonFloat `json:"xlow"`
+		XHigh jsonFloat `json:"xhigh"`
+		jsonPdbn
+	}
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	o := newPbin1d(float64(j.XLow), float64(j.XHigh))
+	o.xdbn = j.X.dbn()
+	o.ydbn = j.Y.dbn()
+	*b = o
+	return nil
+}
+
+// Bin2D
+
+// MarshalJSON implements json.Marshaler
+func (b Bin2D) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonBin2D{
+		XLow:      jsonFloat(b.edges[0][0]),
+		XHigh:     jsonFloat(b.edges[0][1]),
+		YLow:      jsonFloat(b.edges[1][0]),
+		YHigh:     jsonFloat(b.edges[1][1]),
+		jsonDbn2D: newJSONDbn2D(&b.dbn),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (b *Bin2D) UnmarshalJSON(data []byte) error {
+	var j jsonBin2D
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	*b = Bin2D{
+		edges: [2][2]float64{{float64(j.XLow), float64(j.XHigh)}, {float64(j.YLow), float64(j.YHigh)}},
+		dbn:   j.dbn(),
+	}
+	return nil
+}
+
+// Histo1D
+
+// MarshalJSON implements json.Marshaler
+func (h Histo1D) MarshalJSON() ([]byte, error) {
+	hdr, err := newJSONHeader(h.Type(), h.ann)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		jsonHeader
+		jsonAxis1D
+	}{hdr, newJSONAxis1D(&h.axis)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (h *Histo1D) UnmarshalJSON(data []byte) error {
+	var j struct {
+		jsonHeader
+		jsonAxis1D
+	}
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	ann, err := j.check(h.Type())
+	if err != nil {
+		return err
+	}
+	a, err := j.axis()
+	if err != nil {
+		return err
+	}
+	*h = Histo1D{obj_impl: obj_impl{ann: ann}, axis: *a}
+	return nil
+}
+
+// Histo2D
+
+// MarshalJSON implements json.Marshaler
+func (h Histo2D) MarshalJSON() ([]byte, error) {
+	hdr, err := newJSONHeader(h.Type(), h.ann)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		jsonHeader
+		jsonAxis2D
+	}{hdr, newJSONAxis2D(&h.axis)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (h *Histo2D) UnmarshalJSON(data []byte) error {
+	var j struct {
+		jsonHeader
+		jsonAxis2D
+	}
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	ann, err := j.check(h.Type())
+	if err != nil {
+		return err
+	}
+	a, err := j.axis()
+	if err != nil {
+		return err
+	}
+	*h = Histo2D{obj_impl: obj_impl{ann: ann}, axis: *a}
+	return nil
+}
+
+// Profile1D
+
+// MarshalJSON implements json.Marshaler
+func (p Profile1D) MarshalJSON() ([]byte, error) {
+	hdr, err := newJSONHeader(p.Type(), p.ann)
+	if err != nil {
+		return nil, err
+	}
+	j := jsonProfile1D{
+		Edges:     newJSONFloats(p.edges),
+		Uniform:   p.uniform,
+		Bins:      make([]jsonPdbn, len(p.bins)),
+		Underflow: newJSONPdbn(&p.underflow),
+		Overflow:  newJSONPdbn(&p.overflow),
+		Total:     newJSONPdbn(&p.dbn),
+	}
+	for i := range p.bins {
+		j.Bins[i] = newJSONPdbn(&p.bins[i])
+	}
+	return json.Marshal(struct {
+		jsonHeader
+		jsonProfile1D
+	}{hdr, j})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *Profile1D) UnmarshalJSON(data []byte) error {
+	var j struct {
+		jsonHeader
+		jsonProfile1D
+	}
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	ann, err := j.check(p.Type())
+	if err != nil {
+		return err
+	}
+	edges := fromJSONFloats(j.Edges)
+	err = checkJSONBins(edges, len(j.Bins))
+	if err != nil {
+		return err
+	}
+	o, err := NewProfile1DFromEdges(edges)
+	if err != nil {
+		return err
+	}
+	o.ann = ann
+	o.uniform = j.Uniform
+	set := func(b *pbin1d, j *jsonPdbn) {
+		b.xdbn = j.X.dbn()
+		b.ydbn = j.Y.dbn()
+	}
+	for i := range o.bins {
+		set(&o.bins[i], &j.Bins[i])
+	}
+	set(&o.underflow, &j.Underflow)
+	set(&o.overflow, &j.Overflow)
+	set(&o.dbn, &j.Total)
+	*p = *o
+	return nil
+}
+
+// Point2D
+
+func newJSONPoint2D(p *Point2D) jsonPoint2D {
+	return jsonPoint2D{
+		X:    jsonFloat(p.coord[0]),
+		Y:    jsonFloat(p.coord[1]),
+		XErr: [2]jsonFloat{jsonFloat(p.err[0][0]), jsonFloat(p.err[0][1])},
+		YErr: [2]jsonFloat{jsonFloat(p.err[1][0]), jsonFloat(p.err[1][1])},
+	}
+}
+
+func (j *jsonPoint2D) point() *Point2D {
+	return &Point2D{
+		coord: [2]float64{float64(j.X), float64(j.Y)},
+		err: [2][2]float64{
+			{float64(j.XErr[0]), float64(j.XErr[1])},
+			{float64(j.YErr[0]), float64(j.YErr[1])},
+		},
+	}
+}
+
+// MarshalJSON implements json.Marshaler
+func (p Point2D) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newJSONPoint2D(&p))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *Point2D) UnmarshalJSON(data []byte) error {
+	var j jsonPoint2D
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	*p = *j.point()
+	return nil
+}
+
+// Scatter2D
+
+// MarshalJSON implements json.Marshaler
+func (s Scatter2D) MarshalJSON() ([]byte, error) {
+	hdr, err := newJSONHeader(s.Type(), s.ann)
+	if err != nil {
+		return nil, err
+	}
+	pts := make([]jsonPoint2D, len(s.points))
+	for i, p := range s.points {
+		pts[i] = newJSONPoint2D(p)
+	}
+	return json.Marshal(struct {
+		jsonHeader
+		Points []jsonPoint2D `json:"points"`
+	}{hdr, pts})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *Scatter2D) UnmarshalJSON(data []byte) error {
+	var j struct {
+		jsonHeader
+		Points []jsonPoint2D `json:"points"`
+	}
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+	ann, err := j.check(s.Type())
+	if err != nil {
+		return err
+	}
+	o := Scatter2D{obj_impl: obj_impl{ann: ann}}
+	if j.Points != nil {
+		o.points = make([]*Point2D, len(j.Points))
+		for i := range j.Points {
+			o.points[i] = j.Points[i].point()
+		}
+	}
+	*s = o
+	return nil
+}
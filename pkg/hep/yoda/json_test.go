@@ -0,0 +1,251 @@
+package yoda
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// sameFloat returns whether a and b are equal, or both NaN
+func sameFloat(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
+}
+
+func TestScatter2DJSONNonFinite(t *testing.T) {
+	num, err := NewHisto1D(4, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	den, err := NewHisto1D(4, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, x := range []float64{0.5, 1.5, 1.5, 3.5} {
+		num.Fill(x, 1)
+	}
+	for _, x := range []float64{0.5, 1.5, 3.5} {
+		den.Fill(x, 2)
+	}
+	s, err := Histo1D_Divide(num, den)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.AddPoint(NewPoint2DAsymErr(5, 1, 0.5, 0.5, math.Inf(-1), math.Inf(+1)))
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the schema: non-finite values are strings
+	var raw struct {
+		Points []struct {
+			Y    interface{}    `json:"y"`
+			YErr [2]interface{} `json:"yerr"`
+		} `json:"points"`
+	}
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(raw.Points) != 5 {
+		t.Fatalf("got %d points, want 5", len(raw.Points))
+	}
+	if y := raw.Points[2].Y; y != "NaN" {
+		t.Errorf("y of the empty bin: got %#v, want %q", y, "NaN")
+	}
+	if y := raw.Points[1].Y; y != 1.0 {
+		t.Errorf("y of a filled bin: got %#v, want 1", y)
+	}
+	if yerr := raw.Points[4].YErr; yerr[0] != "-Inf" || yerr[1] != "+Inf" {
+		t.Errorf("infinite y-errors: got %#v, want [\"-Inf\", \"+Inf\"]", yerr)
+	}
+
+	var o Scatter2D
+	err = json.Unmarshal(data, &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o.points) != len(s.points) {
+		t.Fatalf("got %d points, want %d", len(o.points), len(s.points))
+	}
+	for i, p := range s.points {
+		q := o.points[i]
+		same := sameFloat(q.coord[0], p.coord[0]) && sameFloat(q.coord[1], p.coord[1])
+		for j := range p.err {
+			same = same && sameFloat(q.err[j][0], p.err[j][0]) && sameFloat(q.err[j][1], p.err[j][1])
+		}
+		if !same {
+			t.Errorf("point %d: got %+v, want %+v", i, *q, *p)
+		}
+	}
+}
+
+func TestJSONInvalidNumber(t *testing.T) {
+	var p Point2D
+	err := json.Unmarshal([]byte(`{"x": "inf", "y": 1, "xerr": [0, 0], "yerr": [0, 0]}`), &p)
+	if !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("got error %v, want %v", err, ErrInvalidEncoding)
+	}
+}
+
+// the types are encoded as values as well as pointers
+var _ = []json.Marshaler{
+	Bin1D{}, pbin1d{}, Bin2D{}, Axis1D{}, Axis2D{},
+	Histo1D{}, Histo2D{}, Profile1D{}, Point2D{}, Scatter2D{},
+}
+
+// checkJSONKeys checks that the JSON object 'data' has the header of the
+// type 'typ' and the given keys, holding arrays of the given lengths (or -1
+// for non-array values)
+func checkJSONKeys(t *testing.T, data []byte, typ string, keys map[string]int) {
+	t.Helper()
+	var raw map[string]interface{}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if raw["type"] != typ || raw["version"] != float64(jsonVersion) {
+		t.Errorf("%s: got type %v and version %v", typ, raw["type"], raw["version"])
+	}
+	if _, ok := raw["annotations"].(map[string]interface{}); !ok {
+		t.Errorf("%s: no annotations object", typ)
+	}
+	for k, n := range keys {
+		v, ok := raw[k]
+		if !ok {
+			t.Errorf("%s: no key %q", typ, k)
+			continue
+		}
+		if n < 0 {
+			continue
+		}
+		if a, ok := v.([]interface{}); !ok || len(a) != n {
+			t.Errorf("%s: key %q: got %v, want an array of length %d", typ, k, v, n)
+		}
+	}
+	if len(raw) != len(keys)+3 {
+		t.Errorf("%s: got %d keys, want %d", typ, len(raw), len(keys)+3)
+	}
+}
+
+func TestHisto1DJSONRoundTrip(t *testing.T) {
+	h := newTestHisto1D(t)
+	data, err := json.Marshal(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkJSONKeys(t, data, "Histo1D", map[string]int{
+		"edges": 11, "uniform": -1, "bins": 10,
+		"underflow": -1, "overflow": -1, "total": -1,
+	})
+	var raw struct {
+		Bins []map[string]float64 `json:"bins"`
+	}
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, b := range raw.Bins {
+		d := &h.axis.bins[i].xdbn
+		want := map[string]float64{
+			"nfills": float64(d.nfills), "sumw": d.sumw, "sumw2": d.sumw2,
+			"sumwx": d.sumwx, "sumwx2": d.sumwx2,
+		}
+		if !reflect.DeepEqual(b, want) {
+			t.Errorf("bin %d: got %v, want %v", i, b, want)
+		}
+	}
+
+	var o Histo1D
+	err = json.Unmarshal(data, &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&o, h) {
+		t.Errorf("JSON round-trip:\ngot:  %+v\nwant: %+v", &o, h)
+	}
+
+	// a histogram held by value in a struct
+	type record struct {
+		Name  string
+		Histo Histo1D
+	}
+	data, err = json.Marshal(record{Name: "h", Histo: *h})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r record
+	err = json.Unmarshal(data, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Name != "h" || !reflect.DeepEqual(&r.Histo, h) {
+		t.Errorf("JSON round-trip by value:\ngot:  %+v\nwant: %+v", &r.Histo, h)
+	}
+}
+
+func TestHisto2DJSONRoundTrip(t *testing.T) {
+	h, err := NewHisto2D(4, -1, 3, 3, -1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testFills(20, func(i int, w float64) {
+		h.Fill(float64(i%6)-1.5, float64(i%5)-1.5, w)
+	})
+	h.SetAnnotation("Title", "test")
+	data, err := json.Marshal(*h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkJSONKeys(t, data, "Histo2D", map[string]int{
+		"xedges": 5, "yedges": 4, "xuniform": -1, "yuniform": -1,
+		"bins": 12, "outflows": 8, "total": -1,
+	})
+
+	var o Histo2D
+	err = json.Unmarshal(data, &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&o, h) {
+		t.Errorf("JSON round-trip:\ngot:  %+v\nwant: %+v", &o, h)
+	}
+}
+
+func TestProfile1DJSONRoundTrip(t *testing.T) {
+	p := newTestProfile1D(t, 0, 1, 2, 4)
+	p.SetAnnotation("Title", "test")
+	data, err := json.Marshal(*p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkJSONKeys(t, data, "Profile1D", map[string]int{
+		"edges": 4, "uniform": -1, "bins": 3,
+		"underflow": -1, "overflow": -1, "total": -1,
+	})
+	// the bins hold the distributions of x and y
+	var raw struct {
+		Bins []map[string]map[string]float64 `json:"bins"`
+	}
+	err = json.Unmarshal(data, &raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, b := range raw.Bins {
+		if b["x"]["sumw"] != p.bins[i].xdbn.sumw || b["y"]["sumwx"] != p.bins[i].ydbn.sumwx {
+			t.Errorf("bin %d: got %v", i, b)
+		}
+	}
+
+	var o Profile1D
+	err = json.Unmarshal(data, &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&o, p) {
+		t.Errorf("JSON round-trip:\ngot:  %+v\nwant: %+v", &o, p)
+	}
+}
@@ -1,7 +1,9 @@
 package yoda
 
 import (
+	"fmt"
 	"math"
+	"sort"
 )
 
 // Compare 2 floating point numbers for equality with a degree of fuzziness
@@ -18,26 +20,117 @@ func fFuzzyEqWithTolerance(a, b, tolerance float64) bool {
 	return (absavg == 0.0 && absdiff == 0.0) || (absdiff < tolerance*absavg)
 }
 
-// Returns a list of nbins+1 values equally spaced between 'start' and 'end' inclusive
-func linspace(start, end float64, nbins int) []float64 {
-	if end < start {
-		//FIXME(binet) proper error handling
-		panic("invalid range")
+// Returns a list of nbins+1 values equally spaced between 'start' and 'end' inclusive.
+// Each value is computed from its index, so that rounding errors do not
+// accumulate, and the last value is exactly 'end'.
+func linspace(start, end float64, nbins int) ([]float64, error) {
+	if !(end > start) || nbins <= 0 || math.IsInf(end-start, 0) {
+		return nil, fmt.Errorf("linspace(%v, %v, %d): %w", start, end, nbins, ErrInvalidRange)
 	}
+	o := make([]float64, nbins+1)
+	interval := (end - start) / float64(nbins)
+	for i := range o {
+		o[i] = start + float64(i)*interval
+	}
+	o[nbins] = end
+	if checkEdges(o) != nil {
+		// the bins are too narrow to be represented
+		return nil, fmt.Errorf("linspace(%v, %v, %d): %w", start, end, nbins, ErrInvalidRange)
+	}
+	return o, nil
+}
+
+// Returns a list of nbins+1 values between 'start' and 'end' inclusive,
+// equally spaced in log(x). 'start' must be strictly positive.
+// The values are computed in base 10, so that decades are exact.
+func logspace(start, end float64, nbins int) ([]float64, error) {
+	if !(start > 0) || math.IsInf(end, 0) {
+		return nil, fmt.Errorf("logspace(%v, %v, %d): %w", start, end, nbins, ErrInvalidRange)
+	}
+	o, err := linspace(math.Log10(start), math.Log10(end), nbins)
+	if err != nil {
+		return nil, fmt.Errorf("logspace(%v, %v, %d): %w", start, end, nbins, ErrInvalidRange)
+	}
+	for i := range o {
+		o[i] = math.Pow(10, o[i])
+	}
+	o[0] = start
+	o[nbins] = end
+	if checkEdges(o) != nil {
+		return nil, fmt.Errorf("logspace(%v, %v, %d): %w", start, end, nbins, ErrInvalidRange)
+	}
+	return o, nil
+}
+
+// Returns a list of nbins+1 values between 'start' and 'end' inclusive,
+// equally spaced in x^(1/power): the bin widths grow as a power law.
+// 'start' must be positive and 'power' strictly positive.
+func pspace(start, end float64, nbins int, power float64) ([]float64, error) {
+	if !(start >= 0) || !(power > 0) || math.IsInf(end, 0) || math.IsInf(power, 0) {
+		return nil, fmt.Errorf("pspace(%v, %v, %d, %v): %w", start, end, nbins, power, ErrInvalidRange)
+	}
+	o, err := linspace(math.Pow(start, 1/power), math.Pow(end, 1/power), nbins)
+	if err != nil {
+		return nil, fmt.Errorf("pspace(%v, %v, %d, %v): %w", start, end, nbins, power, ErrInvalidRange)
+	}
+	for i := range o {
+		o[i] = math.Pow(o[i], power)
+	}
+	o[0] = start
+	o[nbins] = end
+	if checkEdges(o) != nil {
+		return nil, fmt.Errorf("pspace(%v, %v, %d, %v): %w", start, end, nbins, power, ErrInvalidRange)
+	}
+	return o, nil
+}
+
+// Returns the quantile 'p' (0 <= p <= 1) of the sorted, non-empty, sample
+// 'xs', interpolated linearly between the sorted values
+func quantile(xs []float64, p float64) float64 {
+	pos := p * float64(len(xs)-1)
+	j := int(pos)
+	q := xs[j]
+	if j+1 < len(xs) {
+		q += (pos - float64(j)) * (xs[j+1] - xs[j])
+	}
+	return q
+}
+
+// Returns the edges of (at most) nbins bins holding the same fraction of
+// the values of 'sample'.
+// The inner edges are the i/nbins quantiles of the sample, interpolated
+// linearly between the sorted values. Edges which coincide, because of
+// repeated values, are merged. The last edge is the next float64 after the
+// largest value, so that all the values fall inside the bins.
+func quantileEdges(sample []float64, nbins int) ([]float64, error) {
 	if nbins <= 0 {
-		//FIXME(binet) proper error handling
-		panic("invalid range")
+		return nil, fmt.Errorf("quantiles of %d bins: %w", nbins, ErrInvalidRange)
 	}
-	o := make([]float64, 0, nbins+1)
-	interval := (end - start) / float64(nbins)
-	for edge := start; edge <= end; edge += interval {
-		o = append(o, edge)
+	xs := make([]float64, len(sample))
+	copy(xs, sample)
+	for _, x := range xs {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return nil, fmt.Errorf("quantiles of a sample with value %v: %w", x, ErrInvalidRange)
+		}
+	}
+	sort.Float64s(xs)
+	if len(xs) < 2 || !(xs[len(xs)-1] > xs[0]) {
+		return nil, fmt.Errorf("quantiles of a sample without distinct values: %w", ErrInvalidRange)
 	}
-	if len(o) != nbins+1 {
-		//FIXME(binet) proper error handling
-		panic("internal error")
+	n := len(xs)
+	edges := make([]float64, 0, nbins+1)
+	edges = append(edges, xs[0])
+	for i := 1; i < nbins; i++ {
+		q := quantile(xs, float64(i)/float64(nbins))
+		if q > edges[len(edges)-1] {
+			edges = append(edges, q)
+		}
 	}
-	return o
+	last := math.Nextafter(xs[n-1], math.Inf(+1))
+	if last > edges[len(edges)-1] {
+		edges = append(edges, last)
+	}
+	return edges, nil
 }
 
 /// Calculates the mean of a sample
@@ -77,3 +170,169 @@ func correlation(s1, s2 []int) float64 {
 	corr := cov / math.Sqrt(var1*var2)
 	return corr * math.Sqrt(var2/var1)
 }
+
+// Returns the regularized incomplete beta function I_x(a, b)
+func betaInc(a, b, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 0.0
+	case x >= 1:
+		return 1.0
+	}
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+	bt := math.Exp(lab - la - lb + a*math.Log(x) + b*math.Log1p(-x))
+	if x < (a+1)/(a+b+2) {
+		return bt * betacf(a, b, x) / a
+	}
+	return 1 - bt*betacf(b, a, 1-x)/b
+}
+
+// Evaluates the continued fraction for the incomplete beta function by the
+// modified Lentz's method
+func betacf(a, b, x float64) float64 {
+	const (
+		maxiter = 300
+		eps     = 3e-16
+		fpmin   = 1e-300
+	)
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < fpmin {
+		d = fpmin
+	}
+	d = 1 / d
+	h := d
+	for m := 1; m <= maxiter; m++ {
+		fm := float64(m)
+		m2 := 2 * fm
+		aa := fm * (b - fm) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < fpmin {
+			d = fpmin
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < fpmin {
+			c = fpmin
+		}
+		d = 1 / d
+		h *= d * c
+		aa = -(a + fm) * (qab + fm) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < fpmin {
+			d = fpmin
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < fpmin {
+			c = fpmin
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+	return h
+}
+
+// Returns the regularized upper incomplete gamma function Q(a, x)
+func gammaIncQ(a, x float64) float64 {
+	switch {
+	case x <= 0:
+		return 1.0
+	case math.IsInf(x, +1):
+		return 0.0
+	}
+	const (
+		maxiter = 1000
+		eps     = 3e-16
+		fpmin   = 1e-300
+	)
+	lga, _ := math.Lgamma(a)
+	pre := math.Exp(-x + a*math.Log(x) - lga)
+	if x < a+1 {
+		// series representation of P(a, x)
+		ap := a
+		sum := 1 / a
+		del := sum
+		for n := 0; n < maxiter; n++ {
+			ap++
+			del *= x / ap
+			sum += del
+			if math.Abs(del) < math.Abs(sum)*eps {
+				break
+			}
+		}
+		return 1 - sum*pre
+	}
+	// continued fraction representation of Q(a, x), by the modified
+	// Lentz's method
+	b := x + 1 - a
+	c := 1 / fpmin
+	d := 1 / b
+	h := d
+	for i := 1; i <= maxiter; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < fpmin {
+			d = fpmin
+		}
+		c = b + an/c
+		if math.Abs(c) < fpmin {
+			c = fpmin
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+	return pre * h
+}
+
+// Returns the probability for the Kolmogorov distribution to exceed 'z',
+// the KS statistic scaled by sqrt(n1*n2/(n1+n2))
+func kolmogorovProb(z float64) float64 {
+	u := math.Abs(z)
+	switch {
+	case u < 0.2:
+		return 1.0
+	case u < 0.755:
+		// sqrt(2*pi)/u * sum_j exp(-(2j-1)^2 pi^2/(8u^2))
+		v := -math.Pi * math.Pi / (8 * u * u)
+		p := math.Exp(v) + math.Exp(9*v) + math.Exp(25*v)
+		return 1 - math.Sqrt(2*math.Pi)*p/u
+	case u < 6.8116:
+		// 2 sum_j (-1)^(j-1) exp(-2 j^2 u^2)
+		v := u * u
+		p := 0.0
+		sign := 1.0
+		for j := 1; j <= 4; j++ {
+			p += sign * math.Exp(-2*float64(j*j)*v)
+			sign = -sign
+		}
+		return 2 * p
+	}
+	return 0.0
+}
+
+// Returns x such that I_x(a, b) = p, by bisection
+func betaIncInv(a, b, p float64) float64 {
+	lo, hi := 0.0, 1.0
+	for i := 0; i < 200; i++ {
+		mid := 0.5 * (lo + hi)
+		if betaInc(a, b, mid) < p {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return 0.5 * (lo + hi)
+}
@@ -1,5 +1,10 @@
 package yoda
 
+import (
+	"fmt"
+	"strconv"
+)
+
 type Annotations map[string]interface{}
 
 type Object interface {
@@ -11,6 +16,24 @@ type Object interface {
 
 	// Check if an annotation 'name' is defined
 	HasAnnotation(name string) bool
+
+	// Retrieve the annotation 'name' and whether it is defined
+	Annotation(name string) (interface{}, bool)
+
+	// Set the annotation 'name' to 'value'
+	SetAnnotation(name string, value interface{})
+
+	// Remove the annotation 'name'
+	RemoveAnnotation(name string)
+
+	// Path returns the path of this analysis object (e.g. "/ANALYSIS/h_pt")
+	Path() string
+
+	// Title returns the title of this analysis object
+	Title() string
+
+	// Type returns the type name of this analysis object (e.g. "Histo1D")
+	Type() string
 }
 
 type Bin interface {
@@ -27,7 +50,126 @@ type Bin interface {
 	SumW2() float64
 }
 
+// obj_impl is the common base of all analysis objects.
+// It holds the annotations, including the path and title of the object.
 type obj_impl struct {
 	ann Annotations
 }
 
+// Retrieve the annotations attached to this analysis object
+func (o *obj_impl) Annotations() Annotations {
+	if o.ann == nil {
+		o.ann = make(Annotations)
+	}
+	return o.ann
+}
+
+// Check if an annotation 'name' is defined
+func (o *obj_impl) HasAnnotation(name string) bool {
+	_, ok := o.ann[name]
+	return ok
+}
+
+// Retrieve the annotation 'name' and whether it is defined
+func (o *obj_impl) Annotation(name string) (interface{}, bool) {
+	v, ok := o.ann[name]
+	return v, ok
+}
+
+// Retrieve the annotation 'name' as a string.
+// Non-string values are formatted with fmt.Sprint.
+func (o *obj_impl) AnnotationString(name string) (string, bool) {
+	v, ok := o.ann[name]
+	if !ok {
+		return "", false
+	}
+	if s, ok := v.(string); ok {
+		return s, true
+	}
+	return fmt.Sprint(v), true
+}
+
+// Retrieve the annotation 'name' as a float64.
+// String values are parsed; false is returned if the conversion fails.
+func (o *obj_impl) AnnotationFloat64(name string) (float64, bool) {
+	v, ok := o.ann[name]
+	if !ok {
+		return 0, false
+	}
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// Retrieve the annotation 'name' as an int.
+// String values are parsed; false is returned if the conversion fails.
+func (o *obj_impl) AnnotationInt(name string) (int, bool) {
+	v, ok := o.ann[name]
+	if !ok {
+		return 0, false
+	}
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case string:
+		i, err := strconv.Atoi(v)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// Set the annotation 'name' to 'value'
+func (o *obj_impl) SetAnnotation(name string, value interface{}) {
+	o.Annotations()[name] = value
+}
+
+// Remove the annotation 'name'
+func (o *obj_impl) RemoveAnnotation(name string) {
+	delete(o.ann, name)
+}
+
+// Path returns the path of this analysis object
+func (o *obj_impl) Path() string {
+	v, _ := o.AnnotationString("Path")
+	return v
+}
+
+// Set the path of this analysis object
+func (o *obj_impl) SetPath(path string) {
+	o.SetAnnotation("Path", path)
+}
+
+// Title returns the title of this analysis object
+func (o *obj_impl) Title() string {
+	v, _ := o.AnnotationString("Title")
+	return v
+}
+
+// Set the title of this analysis object
+func (o *obj_impl) SetTitle(title string) {
+	o.SetAnnotation("Title", title)
+}
+
+// check all analysis objects implement the Object interface
+var (
+	_ Object = (*Histo1D)(nil)
+	_ Object = (*Histo2D)(nil)
+	_ Object = (*Profile1D)(nil)
+	_ Object = (*Scatter2D)(nil)
+)
+
@@ -134,3 +134,31 @@ func Point2D_Eq(p1, p2 *Point2D) bool {
 		fFuzzyEq(p1.XErrMinus(), p2.XErrMinus()) &&
 		fFuzzyEq(p1.XErrPlus(), p2.XErrPlus())
 }
+
+// Compares 2 Point2Ds, by x-value then by x-errors.
+// The comparison is exact, so that it is a strict weak ordering usable for
+// sorting: a fuzzy comparison is not transitive.
+func Point2D_Less(p1, p2 *Point2D) bool {
+	if p1.X() != p2.X() {
+		return p1.X() < p2.X()
+	}
+	if p1.XErrMinus() != p2.XErrMinus() {
+		return p1.XErrMinus() < p2.XErrMinus()
+	}
+	return p1.XErrPlus() < p2.XErrPlus()
+}
+
+// Apply the function 'f' to the coordinate 'dim' of the point.
+// The errors are propagated by transforming the error band edges, which
+// assumes 'f' is monotonic over that band.
+func (p *Point2D) transform(dim int, f func(float64) float64) {
+	v := f(p.coord[dim])
+	lo := f(p.coord[dim] - p.err[dim][0])
+	hi := f(p.coord[dim] + p.err[dim][1])
+	if lo > hi {
+		// decreasing function: swap the error band edges
+		lo, hi = hi, lo
+	}
+	p.coord[dim] = v
+	p.err[dim] = [2]float64{v - lo, hi - v}
+}
@@ -0,0 +1,331 @@
+package yoda
+
+import (
+	"fmt"
+	"math"
+)
+
+// A bin in a 1D profile histogram.
+// It holds the distribution of x-values (in its Bin1D) as well as the
+// "hidden" distribution of y-values.
+type pbin1d struct {
+	Bin1D
+
+	// distribution of weighted y-values
+	ydbn dbn1d
+}
+
+func newPbin1d(low, hi float64) pbin1d {
+	return pbin1d{Bin1D: *NewBin1D(low, hi)}
+}
+
+// Fill this bin with weight 'weight' at position ('x', 'y')
+func (p *pbin1d) fill(x, y, weight float64) {
+	p.Bin1D.xdbn.fill(x, weight)
+	p.ydbn.fill(y, weight)
+}
+
+// Fill this bin with weight 'weight' at y-value 'y'
+func (p *pbin1d) fillBin(y, weight float64) {
+	p.fill(p.Bin1D.MidPoint(), y, weight)
+}
+
+// Reset this bin
+func (p *pbin1d) Reset() {
+	p.Bin1D.Reset()
+	p.ydbn.Reset()
+}
+
+func (p *pbin1d) scaleW(scale float64) {
+	p.Bin1D.scaleW(scale)
+	p.ydbn.scaleW(scale)
+}
+
+// Returns the mean of y-values in the bin
+func (p *pbin1d) YMean() (float64, error) {
+	return p.ydbn.mean()
+}
+
+// Returns the variance of y-values in the bin
+func (p *pbin1d) YVariance() (float64, error) {
+	return p.ydbn.variance()
+}
+
+// Returns the standard deviation (spread) of y-values in the bin
+func (p *pbin1d) YStdDev() (float64, error) {
+	return p.ydbn.stdDev()
+}
+
+// Returns the standard error on the mean of y-values in the bin
+func (p *pbin1d) YStdErr() (float64, error) {
+	return p.ydbn.stdErr()
+}
+
+// Returns the sum of y*weight
+func (p *pbin1d) SumWY() float64 {
+	return p.ydbn.sumwx
+}
+
+// Returns the sum of y**2 * weight
+func (p *pbin1d) SumWY2() float64 {
+	return p.ydbn.sumwx2
+}
+
+// in-place sum of the input bins: a += b
+func pbin1d_iadd(a, b *pbin1d) error {
+	err := Bin1D_IAdd(&a.Bin1D, &b.Bin1D)
+	if err != nil {
+		return err
+	}
+	return dbn1d_iadd(&a.ydbn, &b.ydbn)
+}
+
+// A one-dimensional profile histogram: the mean (and spread) of y-values
+// binned in x
+type Profile1D struct {
+	obj_impl
+
+	// the bins contained in this histogram
+	bins []pbin1d
+	// a distribution counter for underflow fills
+	underflow pbin1d
+	// a distribution counter for overflow fills
+	overflow pbin1d
+
+	// a distribution counter for the whole histogram
+	dbn pbin1d
+
+	// bin edges: lower edges, except last entry, which is the high edge of the last entry
+	edges []float64
+
+	// whether the edges are equally spaced, enabling O(1) bin lookup
+	uniform bool
+}
+
+// Create a new Profile1D from a list of bin edges.
+// The edges must be strictly increasing.
+func NewProfile1DFromEdges(edges []float64) (*Profile1D, error) {
+	err := checkEdges(edges)
+	if err != nil {
+		return nil, err
+	}
+	nbins := len(edges) - 1
+	lo := edges[0]
+	hi := edges[nbins]
+	p := &Profile1D{
+		bins:      make([]pbin1d, 0, nbins),
+		underflow: newPbin1d(math.Inf(-1), lo),
+		overflow:  newPbin1d(hi, math.Inf(+1)),
+		dbn:       newPbin1d(math.Inf(-1), math.Inf(+1)),
+		edges:     make([]float64, len(edges)),
+	}
+	copy(p.edges, edges)
+	for i := 0; i < nbins; i++ {
+		p.bins = append(p.bins, newPbin1d(edges[i], edges[i+1]))
+	}
+	return p, nil
+}
+
+// Create a new Profile1D with 'nbins' bins equally spaced between 'lower'
+// and 'upper'
+func NewProfile1D(nbins int, lower, upper float64) (*Profile1D, error) {
+	edges, err := linspace(lower, upper, nbins)
+	if err != nil {
+		return nil, err
+	}
+	p, err := NewProfile1DFromEdges(edges)
+	if err != nil {
+		return nil, err
+	}
+	p.uniform = true
+	return p, nil
+}
+
+// Type returns the type name of this analysis object
+func (p *Profile1D) Type() string {
+	return "Profile1D"
+}
+
+// Returns the number of bins (not counting under|over-flows)
+func (p *Profile1D) NumBins() uint64 {
+	return uint64(len(p.bins))
+}
+
+// Returns the bins of this profile
+func (p *Profile1D) Bins() []pbin1d {
+	return p.bins
+}
+
+// Returns the bin at bin number 'id'
+func (p *Profile1D) Bin(id int) *pbin1d {
+	return &p.bins[id]
+}
+
+// Returns the index of the bin containing coordinate 'x', or
+// UnderflowIndex (resp. OverflowIndex) if 'x' is out of range
+func (p *Profile1D) BinIndex(x float64) int {
+	return findBin(p.edges, p.uniform, x)
+}
+
+// Returns the bin at coordinate 'x', or nil if 'x' is out of range
+func (p *Profile1D) BinByCoord(x float64) *pbin1d {
+	id := p.BinIndex(x)
+	if id < 0 {
+		return nil
+	}
+	return &p.bins[id]
+}
+
+// Returns the low edge of the profile
+func (p *Profile1D) LowEdge() float64 {
+	return p.edges[0]
+}
+
+// Returns the high edge of the profile
+func (p *Profile1D) HighEdge() float64 {
+	return p.edges[len(p.edges)-1]
+}
+
+// Returns the distribution of fills below the low edge
+func (p *Profile1D) Underflow() *pbin1d {
+	return &p.underflow
+}
+
+// Returns the distribution of fills at or above the high edge
+func (p *Profile1D) Overflow() *pbin1d {
+	return &p.overflow
+}
+
+// Fill the profile with weight 'weight' at position ('x', 'y')
+func (p *Profile1D) Fill(x, y, weight float64) {
+	p.dbn.fill(x, y, weight)
+	switch id := p.BinIndex(x); id {
+	case UnderflowIndex:
+		p.underflow.fill(x, y, weight)
+	case OverflowIndex:
+		p.overflow.fill(x, y, weight)
+	default:
+		p.bins[id].fill(x, y, weight)
+	}
+}
+
+// Fill the bin number 'id' with weight 'weight' at y-value 'y'
+func (p *Profile1D) FillBin(id int, y, weight float64) {
+	bin := &p.bins[id]
+	p.dbn.fill(bin.MidPoint(), y, weight)
+	bin.fillBin(y, weight)
+}
+
+// Reset the profile content, keeping the binning
+func (p *Profile1D) Reset() {
+	p.dbn.Reset()
+	p.underflow.Reset()
+	p.overflow.Reset()
+	for i := range p.bins {
+		p.bins[i].Reset()
+	}
+}
+
+// Scale the weights of all bins (and under|over-flows) by 'scale'
+func (p *Profile1D) ScaleW(scale float64) {
+	p.dbn.scaleW(scale)
+	p.underflow.scaleW(scale)
+	p.overflow.scaleW(scale)
+	for i := range p.bins {
+		p.bins[i].scaleW(scale)
+	}
+}
+
+// Returns the total number of fills, including under|over-flows
+func (p *Profile1D) NumEntries() uint64 {
+	return p.dbn.NumEntries()
+}
+
+// Returns the sum of weights, including under|over-flows
+func (p *Profile1D) SumW() float64 {
+	return p.dbn.SumW()
+}
+
+// Returns the sum of weights squared, including under|over-flows
+func (p *Profile1D) SumW2() float64 {
+	return p.dbn.SumW2()
+}
+
+// Returns the mean x-value of all fills, including under|over-flows
+func (p *Profile1D) XMean() (float64, error) {
+	return p.dbn.XMean()
+}
+
+// Returns the mean y-value of all fills, including under|over-flows
+func (p *Profile1D) YMean() (float64, error) {
+	return p.dbn.YMean()
+}
+
+// Returns the per-bin y-means as a list of points.
+// The x-errors span the bin widths, the y-errors are the standard errors
+// on the means. Bins without net weight have a NaN y-value and no y-error.
+func (p *Profile1D) Points() []*Point2D {
+	pts := make([]*Point2D, 0, len(p.bins))
+	for i := range p.bins {
+		bin := &p.bins[i]
+		ex := 0.5 * bin.Width()
+		y, err := bin.ydbn.mean()
+		if err != nil {
+			y = math.NaN()
+		}
+		ey, err := bin.ydbn.stdErr()
+		if err != nil {
+			ey = 0.0
+		}
+		pts = append(pts, NewPoint2DErr(bin.MidPoint(), y, ex, ey))
+	}
+	return pts
+}
+
+// In-place add of 2 profiles: a += b.
+// The profiles are left unchanged if their binnings differ.
+func Profile1D_IAdd(a, b *Profile1D) error {
+	if len(a.bins) != len(b.bins) {
+		return fmt.Errorf("iadd: profiles' number of bins differ: %w", ErrBinningMismatch)
+	}
+	for i := range a.bins {
+		if a.bins[i].edges != b.bins[i].edges {
+			return fmt.Errorf("iadd: profiles' edges of bin %d differ (%v != %v): %w",
+				i, a.bins[i].edges, b.bins[i].edges, ErrBinningMismatch)
+		}
+	}
+	for _, v := range [][2]*pbin1d{
+		{&a.underflow, &b.underflow},
+		{&a.overflow, &b.overflow},
+		{&a.dbn, &b.dbn},
+	} {
+		dbn1d_iadd(&v[0].xdbn, &v[1].xdbn)
+		dbn1d_iadd(&v[0].ydbn, &v[1].ydbn)
+	}
+	for i := range a.bins {
+		pbin1d_iadd(&a.bins[i], &b.bins[i])
+	}
+	return nil
+}
+
+// Returns a new profile, the sum of the input profiles.
+// The annotations of 'a' are copied over.
+func Profile1D_Add(a, b *Profile1D) (*Profile1D, error) {
+	o, err := NewProfile1DFromEdges(a.edges)
+	if err != nil {
+		return nil, err
+	}
+	o.uniform = a.uniform
+	for k, v := range a.ann {
+		o.SetAnnotation(k, v)
+	}
+	err = Profile1D_IAdd(o, a)
+	if err != nil {
+		return nil, err
+	}
+	err = Profile1D_IAdd(o, b)
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
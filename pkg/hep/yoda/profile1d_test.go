@@ -0,0 +1,137 @@
+package yoda
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProfile1DFill(t *testing.T) {
+	p, err := NewProfile1D(4, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Fill(-1, 10, 1)
+	p.Fill(0.5, 1, 1)
+	p.Fill(0.5, 3, 3)
+	p.Fill(2.5, 4, 2)
+	p.Fill(4, 20, 1)
+	p.FillBin(3, 6, 1)
+
+	if n := p.NumEntries(); n != 6 {
+		t.Errorf("got %d entries, want 6", n)
+	}
+	if w := p.SumW(); w != 9 {
+		t.Errorf("got sumw=%v, want 9", w)
+	}
+	if n := p.Underflow().NumEntries(); n != 1 {
+		t.Errorf("underflow: got %d entries, want 1", n)
+	}
+	if n := p.Overflow().NumEntries(); n != 1 {
+		t.Errorf("overflow: got %d entries, want 1", n)
+	}
+	for _, c := range []struct {
+		bin   int
+		n     uint64
+		ymean float64
+	}{
+		{0, 2, (1*1 + 3*3) / 4.0},
+		{2, 1, 4},
+		{3, 1, 6},
+	} {
+		b := p.Bin(c.bin)
+		if b.NumEntries() != c.n {
+			t.Errorf("bin %d: got %d entries, want %d", c.bin, b.NumEntries(), c.n)
+		}
+		ymean, err := b.YMean()
+		if err != nil {
+			t.Errorf("bin %d: %v", c.bin, err)
+			continue
+		}
+		if ymean != c.ymean {
+			t.Errorf("bin %d: got y-mean %v, want %v", c.bin, ymean, c.ymean)
+		}
+	}
+	// FillBin fills at the middle of the bin
+	if x, _ := p.Bin(3).XMean(); x != 3.5 {
+		t.Errorf("FillBin: got x-mean %v, want 3.5", x)
+	}
+	ymean, err := p.YMean()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (10 + 1 + 9 + 8 + 20 + 6) / 9.0; math.Abs(ymean-want) > 1e-12 {
+		t.Errorf("got y-mean %v, want %v", ymean, want)
+	}
+}
+
+func TestProfile1DPoints(t *testing.T) {
+	p, err := NewProfile1D(3, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Fill(0.5, 1, 1)
+	p.Fill(0.5, 3, 1)
+	p.Fill(2.5, 5, 1)
+
+	pts := p.Points()
+	if len(pts) != 3 {
+		t.Fatalf("got %d points, want 3", len(pts))
+	}
+	for i, want := range []struct{ x, y, ex, ey float64 }{
+		{0.5, 2, 0.5, 1},
+		{1.5, math.NaN(), 0.5, 0},
+		{2.5, 5, 0.5, 0},
+	} {
+		pt := pts[i]
+		if pt.X() != want.x || !sameFloat(pt.Y(), want.y) {
+			t.Errorf("point %d: got (%v, %v), want (%v, %v)", i, pt.X(), pt.Y(), want.x, want.y)
+		}
+		if ex := pt.XErrAvg(); ex != want.ex {
+			t.Errorf("point %d: got x-error %v, want %v", i, ex, want.ex)
+		}
+		if ey := pt.YErrAvg(); math.Abs(ey-want.ey) > 1e-12 {
+			t.Errorf("point %d: got y-error %v, want %v", i, ey, want.ey)
+		}
+	}
+}
+
+func TestProfile1DAdd(t *testing.T) {
+	a := newTestProfile1D(t, 0, 1, 2, 3, 4)
+	a.SetTitle("a")
+	b := newTestProfile1D(t, 0, 1, 2, 3, 4)
+	b.SetTitle("b")
+
+	o, err := Profile1D_Add(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Title() != "a" {
+		t.Errorf("got title %q, want %q", o.Title(), "a")
+	}
+	if o.NumEntries() != a.NumEntries()+b.NumEntries() {
+		t.Errorf("got %d entries, want %d", o.NumEntries(), a.NumEntries()+b.NumEntries())
+	}
+
+	err = Profile1D_IAdd(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range a.bins {
+		ab, ob := &a.bins[i], &o.bins[i]
+		if ab.xdbn != ob.xdbn || ab.ydbn != ob.ydbn {
+			t.Errorf("bin %d: IAdd and Add differ: %+v != %+v", i, *ab, *ob)
+		}
+		if want := 2 * b.bins[i].SumWY(); ab.SumWY() != want {
+			t.Errorf("bin %d: got sumwy=%v, want %v", i, ab.SumWY(), want)
+		}
+	}
+	for _, v := range [][2]*pbin1d{
+		{&a.underflow, &o.underflow},
+		{&a.overflow, &o.overflow},
+		{&a.dbn, &o.dbn},
+	} {
+		if *v[0] != *v[1] {
+			t.Errorf("IAdd and Add differ: %+v != %+v", *v[0], *v[1])
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package yoda
+
+import (
+	"fmt"
+	"sort"
+)
+
+// A collection of 2D data points with errors
+type Scatter2D struct {
+	obj_impl
+	points []*Point2D
+}
+
+// Create a new Scatter2D from a list of points
+func NewScatter2D(pts ...*Point2D) *Scatter2D {
+	s := &Scatter2D{points: make([]*Point2D, 0, len(pts))}
+	s.AddPoints(pts...)
+	return s
+}
+
+// Create a new Scatter2D from a Histo1D.
+// Each bin is converted into a point at the bin midpoint, with x-errors
+// spanning the bin width, y-value the bin height and y-errors the height
+// error.
+func NewScatter2DFromHisto1D(h *Histo1D) *Scatter2D {
+	s := &Scatter2D{points: make([]*Point2D, 0, len(h.axis.bins))}
+	for i := range h.axis.bins {
+		bin := &h.axis.bins[i]
+		ex := 0.5 * bin.Width()
+		s.points = append(s.points,
+			NewPoint2DErr(bin.MidPoint(), bin.height(), ex, bin.heightError()))
+	}
+	return s
+}
+
+// Create a new Scatter2D from the per-bin y-means of a Profile1D
+func NewScatter2DFromProfile1D(p *Profile1D) *Scatter2D {
+	return &Scatter2D{points: p.Points()}
+}
+
+// Type returns the type name of this analysis object
+func (s *Scatter2D) Type() string {
+	return "Scatter2D"
+}
+
+// Returns the number of points
+func (s *Scatter2D) NumPoints() int {
+	return len(s.points)
+}
+
+// Returns the points of the scatter
+func (s *Scatter2D) Points() []*Point2D {
+	return s.points
+}
+
+// Returns the point at index 'i'
+func (s *Scatter2D) Point(i int) *Point2D {
+	return s.points[i]
+}
+
+// Add a point to the scatter
+func (s *Scatter2D) AddPoint(p *Point2D) {
+	s.points = append(s.points, p)
+}
+
+// Add a list of points to the scatter
+func (s *Scatter2D) AddPoints(pts ...*Point2D) {
+	s.points = append(s.points, pts...)
+}
+
+// Remove the point at index 'i'
+func (s *Scatter2D) RemovePoint(i int) error {
+	if i < 0 || i >= len(s.points) {
+		return fmt.Errorf("scatter2d: point index %d: %w", i, ErrIndexOutOfRange)
+	}
+	copy(s.points[i:], s.points[i+1:])
+	s.points[len(s.points)-1] = nil
+	s.points = s.points[:len(s.points)-1]
+	return nil
+}
+
+// Sort the points by increasing x-value
+func (s *Scatter2D) Sort() {
+	sort.Sort(sorted_point2ds(s.points))
+}
+
+// Remove all the points of the scatter
+func (s *Scatter2D) Reset() {
+	s.points = s.points[:0]
+}
+
+// Apply the function 'f' to the x-values of all points, propagating the
+// x-errors. 'f' is assumed to be monotonic over each error band.
+func (s *Scatter2D) TransformX(f func(float64) float64) {
+	for _, p := range s.points {
+		p.transform(0, f)
+	}
+}
+
+// Apply the function 'f' to the y-values of all points, propagating the
+// y-errors. 'f' is assumed to be monotonic over each error band.
+func (s *Scatter2D) TransformY(f func(float64) float64) {
+	for _, p := range s.points {
+		p.transform(1, f)
+	}
+}
+
+// Returns a new scatter holding copies of the points of all input scatters,
+// sorted by increasing x-value
+func Scatter2D_Combine(scatters ...*Scatter2D) *Scatter2D {
+	n := 0
+	for _, s := range scatters {
+		n += len(s.points)
+	}
+	o := &Scatter2D{points: make([]*Point2D, 0, n)}
+	for _, s := range scatters {
+		for _, p := range s.points {
+			pp := *p
+			o.points = append(o.points, &pp)
+		}
+	}
+	o.Sort()
+	return o
+}
+
+// a list of sorted Point2Ds
+type sorted_point2ds []*Point2D
+
+func (s sorted_point2ds) Len() int {
+	return len(s)
+}
+
+func (s sorted_point2ds) Less(i, j int) bool {
+	return Point2D_Less(s[i], s[j])
+}
+
+func (s sorted_point2ds) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
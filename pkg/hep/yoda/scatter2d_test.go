@@ -0,0 +1,134 @@
+package yoda
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestScatter2DPoints(t *testing.T) {
+	s := NewScatter2D(NewPoint2D(1, 2), NewPoint2D(2, 3))
+	s.AddPoint(NewPoint2DErr(3, 4, 0.5, 1))
+	s.AddPoints(NewPoint2D(4, 5), NewPoint2D(5, 6))
+	if s.NumPoints() != 5 {
+		t.Fatalf("got %d points, want 5", s.NumPoints())
+	}
+	err := s.RemovePoint(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, x := range []float64{1, 3, 4, 5} {
+		if p := s.Point(i); p.X() != x {
+			t.Errorf("point %d: got x=%v, want %v", i, p.X(), x)
+		}
+	}
+	for _, i := range []int{-1, 4} {
+		err := s.RemovePoint(i)
+		if !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("remove point %d: got error %v, want %v", i, err, ErrIndexOutOfRange)
+		}
+	}
+	s.Reset()
+	if s.NumPoints() != 0 {
+		t.Errorf("reset: got %d points, want 0", s.NumPoints())
+	}
+}
+
+func TestScatter2DSort(t *testing.T) {
+	// x-values closer than the fuzzy-comparison tolerance
+	rnd := rand.New(rand.NewSource(1))
+	s := NewScatter2D()
+	for i := 0; i < 200; i++ {
+		x := 1 + float64(rnd.Intn(20))*4e-6
+		s.AddPoint(NewPoint2DAsymErr(x, float64(i), float64(rnd.Intn(3)), float64(rnd.Intn(3)), 0, 0))
+	}
+	s.Sort()
+	pts := s.Points()
+	for i := 1; i < len(pts); i++ {
+		if Point2D_Less(pts[i], pts[i-1]) {
+			t.Fatalf("points %d and %d not sorted: %+v > %+v", i-1, i, *pts[i-1], *pts[i])
+		}
+		if pts[i].X() < pts[i-1].X() {
+			t.Fatalf("points %d and %d not sorted by x: %v > %v", i-1, i, pts[i-1].X(), pts[i].X())
+		}
+	}
+
+	a := NewPoint2DAsymErr(1, 0, 0.5, 1, 0, 0)
+	b := NewPoint2DAsymErr(1, 0, 0.5, 2, 0, 0)
+	c := NewPoint2DAsymErr(1+1e-9, 0, 0, 0, 0, 0)
+	for _, v := range []struct {
+		p1, p2 *Point2D
+		want   bool
+	}{
+		{a, b, true},
+		{b, a, false},
+		{a, a, false},
+		{b, c, true},
+		{c, a, false},
+	} {
+		if got := Point2D_Less(v.p1, v.p2); got != v.want {
+			t.Errorf("Point2D_Less(%+v, %+v) = %v, want %v", *v.p1, *v.p2, got, v.want)
+		}
+	}
+}
+
+func TestScatter2DCombine(t *testing.T) {
+	s1 := NewScatter2D(NewPoint2D(3, 1), NewPoint2D(1, 1))
+	s2 := NewScatter2D(NewPoint2D(2, 2))
+	o := Scatter2D_Combine(s1, s2)
+	if o.NumPoints() != 3 {
+		t.Fatalf("got %d points, want 3", o.NumPoints())
+	}
+	for i, x := range []float64{1, 2, 3} {
+		if p := o.Point(i); p.X() != x {
+			t.Errorf("point %d: got x=%v, want %v", i, p.X(), x)
+		}
+	}
+	// the points are copied
+	o.Point(0).SetY(10)
+	if s1.Point(1).Y() != 1 {
+		t.Errorf("combined scatter shares its points with its inputs")
+	}
+}
+
+func TestScatter2DTransform(t *testing.T) {
+	s := NewScatter2D(NewPoint2DAsymErr(10, 100, 5, 10, 90, 900))
+	s.TransformX(math.Log10)
+	s.TransformY(func(y float64) float64 { return -y })
+	p := s.Point(0)
+	if math.Abs(p.X()-1) > 1e-12 {
+		t.Errorf("got x=%v, want 1", p.X())
+	}
+	if exm, exp := p.XErrs(); math.Abs(exm-(1-math.Log10(5))) > 1e-12 || math.Abs(exp-(math.Log10(20)-1)) > 1e-12 {
+		t.Errorf("got x-errors (%v, %v)", exm, exp)
+	}
+	// a decreasing function swaps the errors
+	if eym, eyp := p.YErrs(); p.Y() != -100 || eym != 900 || eyp != 90 {
+		t.Errorf("got y=%v, y-errors (%v, %v), want -100, (900, 90)", p.Y(), eym, eyp)
+	}
+}
+
+func TestScatter2DFromHisto1D(t *testing.T) {
+	h, err := NewHisto1D(2, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Fill(0.5, 2)
+	h.Fill(0.5, 2)
+	h.Fill(1.5, 3)
+	s := NewScatter2DFromHisto1D(h)
+	if s.NumPoints() != 2 {
+		t.Fatalf("got %d points, want 2", s.NumPoints())
+	}
+	// the height errors are sqrt(sumw)/width
+	for i, want := range []struct{ x, y, ex, ey float64 }{
+		{0.5, 4, 0.5, 2},
+		{1.5, 3, 0.5, math.Sqrt(3)},
+	} {
+		p := s.Point(i)
+		if p.X() != want.x || p.Y() != want.y || p.XErrAvg() != want.ex || math.Abs(p.YErrAvg()-want.ey) > 1e-12 {
+			t.Errorf("point %d: got %+v, want %+v", i, *p, want)
+		}
+	}
+}
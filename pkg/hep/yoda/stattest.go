@@ -0,0 +1,345 @@
+package yoda
+
+import (
+	"fmt"
+	"math"
+)
+
+// TestResult holds the outcome of a statistical compatibility test between
+// two histograms
+type TestResult struct {
+	// the test statistic
+	Stat float64
+	// the number of degrees of freedom of the statistic (0 if it is not
+	// defined for the test)
+	NDF int
+	// the probability to observe a statistic at least as extreme if both
+	// histograms sample the same distribution
+	PValue float64
+}
+
+// Chi2Mode tells Chi2Test whether the compared histograms are weighted
+type Chi2Mode int
+
+const (
+	// Chi2UU compares two unweighted histograms: the bin contents are
+	// event counts
+	Chi2UU Chi2Mode = iota
+	// Chi2UW compares an unweighted histogram (the first one) with a
+	// weighted one
+	Chi2UW
+	// Chi2WW compares two weighted histograms
+	Chi2WW
+)
+
+// Chi2Test tests the compatibility of the histograms 'a' and 'b' with the
+// chi-square test of homogeneity for weighted histograms of N. Gagunashvili
+// (Nucl. Instrum. Meth. A 614 (2010) 287).
+// The histograms are normalised to each other; the errors of weighted
+// histograms are taken from their sum of squared weights. The under- and
+// overflow are not used.
+// Bins empty in both histograms are skipped. With Chi2UW, the error of an
+// empty bin of the weighted histogram is taken as the mean weight of its
+// fills. The number of degrees of freedom is the number of used bins minus
+// one.
+// The statistic only follows a chi-square distribution when the bins are
+// well populated: sparse bins, in the tails, should be merged first.
+func Chi2Test(a, b *Histo1D, mode Chi2Mode) (TestResult, error) {
+	err := checkBinning(a, b)
+	if err != nil {
+		return TestResult{}, err
+	}
+	var n1, n2 float64
+	for i := range a.axis.bins {
+		n1 += a.axis.bins[i].SumW()
+		n2 += b.axis.bins[i].SumW()
+	}
+	if !(n1 > 0) || !(n2 > 0) {
+		return TestResult{}, fmt.Errorf("chi2 test of histograms with sums of weights %v and %v: %w", n1, n2, ErrLowStats)
+	}
+	chi2 := 0.0
+	ndf := -1
+	switch mode {
+	case Chi2UU:
+		for i := range a.axis.bins {
+			c1 := a.axis.bins[i].SumW()
+			c2 := b.axis.bins[i].SumW()
+			if c1+c2 == 0 {
+				continue
+			}
+			d := n2*c1 - n1*c2
+			chi2 += d * d / (c1 + c2)
+			ndf++
+		}
+		chi2 /= n1 * n2
+
+	case Chi2UW:
+		// mean weight of the weighted histogram
+		meanw := 0.0
+		for i := range b.axis.bins {
+			meanw += b.axis.bins[i].SumW2()
+		}
+		meanw /= n2
+		for i := range a.axis.bins {
+			c1 := a.axis.bins[i].SumW()
+			c2 := b.axis.bins[i].SumW()
+			e2 := b.axis.bins[i].SumW2()
+			if c1 == 0 && c2 == 0 {
+				continue
+			}
+			if e2 == 0 {
+				e2 = meanw
+			}
+			// maximum likelihood estimate of the probability of the bin
+			v1 := n2*c2 - n1*e2
+			v2 := math.Sqrt(v1*v1 + 4*n2*n2*c1*e2)
+			p := (v1 + v2) / (2 * n2 * n2)
+			if p > 0 {
+				d1 := c1 - n1*p
+				chi2 += d1 * d1 / (n1 * p)
+			}
+			d2 := c2 - n2*p
+			chi2 += d2 * d2 / e2
+			ndf++
+		}
+
+	case Chi2WW:
+		for i := range a.axis.bins {
+			c1 := a.axis.bins[i].SumW()
+			c2 := b.axis.bins[i].SumW()
+			e1 := a.axis.bins[i].SumW2()
+			e2 := b.axis.bins[i].SumW2()
+			if e1 == 0 && e2 == 0 {
+				continue
+			}
+			d := n2*c1 - n1*c2
+			chi2 += d * d / (n2*n2*e1 + n1*n1*e2)
+			ndf++
+		}
+
+	default:
+		return TestResult{}, fmt.Errorf("invalid chi2 test mode %d: %w", int(mode), ErrInvalidRange)
+	}
+	if ndf < 1 {
+		return TestResult{}, fmt.Errorf("chi2 test with %d non-empty bins: %w", ndf+1, ErrLowStats)
+	}
+	return TestResult{
+		Stat:   chi2,
+		NDF:    ndf,
+		PValue: gammaIncQ(0.5*float64(ndf), 0.5*chi2),
+	}, nil
+}
+
+// KolmogorovTest tests the compatibility of the histograms 'a' and 'b'
+// with the Kolmogorov-Smirnov test.
+// The statistic is the maximum distance between the normalised cumulative
+// distributions of the histograms. The p-value is computed from the
+// asymptotic Kolmogorov distribution, with the effective numbers of
+// entries of the histograms: it is only an approximation for binned data,
+// and too large when the bins are wide. NDF is 0.
+// The under- and overflow are not used.
+func KolmogorovTest(a, b *Histo1D) (TestResult, error) {
+	err := checkBinning(a, b)
+	if err != nil {
+		return TestResult{}, err
+	}
+	var n1, n2, w1, w2 float64
+	for i := range a.axis.bins {
+		n1 += a.axis.bins[i].SumW()
+		n2 += b.axis.bins[i].SumW()
+		w1 += a.axis.bins[i].SumW2()
+		w2 += b.axis.bins[i].SumW2()
+	}
+	if !(n1 > 0) || !(n2 > 0) {
+		return TestResult{}, fmt.Errorf("KS test of histograms with sums of weights %v and %v: %w", n1, n2, ErrLowStats)
+	}
+	dmax := 0.0
+	var c1, c2 float64
+	for i := range a.axis.bins {
+		c1 += a.axis.bins[i].SumW() / n1
+		c2 += b.axis.bins[i].SumW() / n2
+		dmax = math.Max(dmax, math.Abs(c1-c2))
+	}
+	// effective numbers of entries
+	neff1 := n1 * n1 / w1
+	neff2 := n2 * n2 / w2
+	z := dmax * math.Sqrt(neff1*neff2/(neff1+neff2))
+	return TestResult{Stat: dmax, PValue: kolmogorovProb(z)}, nil
+}
+
+// AndersonDarlingTest tests the compatibility of the histograms 'a' and
+// 'b' with the k-sample Anderson-Darling test for discrete data of F.W.
+// Scholz and M.A. Stephens (J. Amer. Statist. Assoc. 82 (1987) 918).
+// The bin contents are used as the numbers of (tied) observations in each
+// bin, so the test is meant for unweighted histograms.
+// The statistic is the standardised statistic T = (A2 - (k-1)) / sigma,
+// with k=2 samples, and NDF is k-1 = 1. The p-value is interpolated from
+// the tabulated critical values of T; it is approximate for p-values
+// outside [0.01, 0.25]. Wide bins, with many tied observations, widen the
+// distribution of T and make the p-value too small.
+// The under- and overflow are not used.
+func AndersonDarlingTest(a, b *Histo1D) (TestResult, error) {
+	err := checkBinning(a, b)
+	if err != nil {
+		return TestResult{}, err
+	}
+	f := [][]float64{
+		make([]float64, len(a.axis.bins)),
+		make([]float64, len(b.axis.bins)),
+	}
+	for j := range a.axis.bins {
+		f[0][j] = a.axis.bins[j].SumW()
+		f[1][j] = b.axis.bins[j].SumW()
+	}
+	return andersonDarling(f)
+}
+
+// andersonDarling returns the k-sample Anderson-Darling test of the samples
+// of tied observations f[i][j], the number of observations of the sample i
+// in the bin j
+func andersonDarling(f [][]float64) (TestResult, error) {
+	k := len(f)
+	n := make([]float64, k)
+	ntot := 0.0
+	for i := range f {
+		for _, fij := range f[i] {
+			n[i] += fij
+		}
+		if !(n[i] > 0) {
+			return TestResult{}, fmt.Errorf("AD test of samples of sizes %v: %w", n, ErrLowStats)
+		}
+		ntot += n[i]
+	}
+	if k < 2 || ntot <= 3 {
+		return TestResult{}, fmt.Errorf("AD test of samples of sizes %v: %w", n, ErrLowStats)
+	}
+
+	// A2akN, eq. (7) of Scholz and Stephens, with the mid-ranks of the
+	// tied observations
+	var a2 float64
+	cum := make([]float64, k) // number of observations of each sample below the bin
+	var bcum float64          // total number of observations below the bin
+	for j := range f[0] {
+		l := 0.0
+		for i := range f {
+			l += f[i][j]
+		}
+		if l == 0 {
+			continue
+		}
+		bj := bcum + 0.5*l
+		den := bj*(ntot-bj) - 0.25*ntot*l
+		if den > 0 {
+			for i := range f {
+				mij := cum[i] + 0.5*f[i][j]
+				d := ntot*mij - n[i]*bj
+				a2 += l / n[i] * d * d / den
+			}
+		}
+		for i := range f {
+			cum[i] += f[i][j]
+		}
+		bcum += l
+	}
+	a2 *= (ntot - 1) / (ntot * ntot)
+
+	// variance of A2akN, eq. (4)
+	hh := 0.0
+	for i := range n {
+		hh += 1 / n[i]
+	}
+	h, g := adHarmonicSums(ntot)
+	kk := float64(k)
+	ca := (4*g-6)*(kk-1) + (10-6*g)*hh
+	cb := (2*g-4)*kk*kk + 8*h*kk + (2*g-14*h-4)*hh - 8*h + 4*g - 6
+	cc := (6*h+2*g-2)*kk*kk + (4*h-4*g+6)*kk + (2*h-6)*hh + 4*h
+	cd := (2*h+6)*kk*kk - 4*h*kk
+	sigma2 := (((ca*ntot+cb)*ntot+cc)*ntot + cd) / ((ntot - 1) * (ntot - 2) * (ntot - 3))
+	if !(sigma2 > 0) {
+		return TestResult{}, fmt.Errorf("AD test with a vanishing variance: %w", ErrLowStats)
+	}
+	t := (a2 - (kk - 1)) / math.Sqrt(sigma2)
+	return TestResult{Stat: t, NDF: k - 1, PValue: adPValue(t, k-1)}, nil
+}
+
+// adHarmonicSums returns the sums
+//  h = sum_{i=1}^{N-1} 1/i
+//  g = sum_{i=1}^{N-2} sum_{j=i+1}^{N-1} 1/((N-i)j)
+// entering the variance of the Anderson-Darling statistic, for a total of
+// 'ntot' observations (rounded to an integer N). Their asymptotic values
+// are used for large N.
+func adHarmonicSums(ntot float64) (float64, float64) {
+	const nmax = 1000000
+	if ntot > nmax {
+		const euler = 0.57721566490153286
+		m := ntot - 1
+		return math.Log(m) + euler + 0.5/m, math.Pi * math.Pi / 6
+	}
+	nn := int(math.Round(ntot))
+	h := 0.0
+	for i := 1; i < nn; i++ {
+		h += 1 / float64(i)
+	}
+	// g = sum_{i=1}^{N-2} 1/(N-i) * (h - sum_{j=1}^{i} 1/j)
+	g := 0.0
+	hi := 0.0
+	for i := 1; i <= nn-2; i++ {
+		hi += 1 / float64(i)
+		g += (h - hi) / float64(nn-i)
+	}
+	return h, g
+}
+
+// adPValue returns the p-value of the standardised k-sample
+// Anderson-Darling statistic 't', with m = k-1.
+// log(p) is fitted with a quadratic polynomial in 't' through the critical
+// values of Scholz and Stephens for p = 0.25, 0.1, 0.05, 0.025 and 0.01,
+// interpolated in m as b0 + b1/sqrt(m) + b2/m. For m = 1 they are 0.325,
+// 1.226, 1.961, 2.718 and 3.752.
+func adPValue(t float64, m int) float64 {
+	var (
+		signif = [5]float64{0.25, 0.1, 0.05, 0.025, 0.01}
+		b0     = [5]float64{0.675, 1.281, 1.645, 1.96, 2.326}
+		b1     = [5]float64{-0.245, 0.25, 0.678, 1.149, 1.822}
+		b2     = [5]float64{-0.105, -0.305, -0.362, -0.391, -0.396}
+	)
+	// normal equations of the least-squares fit of log(p) = c0 + c1*t + c2*t**2
+	var st [5]float64 // sums of t**i
+	var sp [3]float64 // sums of log(p)*t**i
+	for i, p := range signif {
+		tp := b0[i] + b1[i]/math.Sqrt(float64(m)) + b2[i]/float64(m)
+		x := 1.0
+		for j := range st {
+			st[j] += x
+			if j < len(sp) {
+				sp[j] += math.Log(p) * x
+			}
+			x *= tp
+		}
+	}
+	det := func(a [3][3]float64) float64 {
+		return a[0][0]*(a[1][1]*a[2][2]-a[1][2]*a[2][1]) -
+			a[0][1]*(a[1][0]*a[2][2]-a[1][2]*a[2][0]) +
+			a[0][2]*(a[1][0]*a[2][1]-a[1][1]*a[2][0])
+	}
+	mat := [3][3]float64{
+		{st[0], st[1], st[2]},
+		{st[1], st[2], st[3]},
+		{st[2], st[3], st[4]},
+	}
+	// Cramer's rule
+	var c [3]float64
+	d := det(mat)
+	for i := range c {
+		mi := mat
+		for j := range mi {
+			mi[j][i] = sp[j]
+		}
+		c[i] = det(mi) / d
+	}
+	if c[2] > 0 {
+		// beyond the minimum of the polynomial the p-value would rise
+		t = math.Min(t, -c[1]/(2*c[2]))
+	}
+	return math.Min(1, math.Exp(c[0]+(c[1]+c[2]*t)*t))
+}
@@ -0,0 +1,228 @@
+package yoda
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+)
+
+// newTestStatHisto1D returns a histogram with 'counts[i]' fills in the bin
+// i, of unit weights or, if 'weighted', of weights 0.5, 1 and 1.5 in turn
+func newTestStatHisto1D(t *testing.T, counts []int, weighted bool) *Histo1D {
+	h, err := NewHisto1D(len(counts), 0, float64(len(counts)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, n := range counts {
+		for j := 0; j < n; j++ {
+			w := 1.0
+			if weighted {
+				w = 0.5 + 0.5*float64(j%3)
+			}
+			h.FillBin(i, w)
+		}
+	}
+	return h
+}
+
+func TestChi2Test(t *testing.T) {
+	// the reference values are computed with the formulas of ROOT's
+	// TH1::Chi2Test, in exact arithmetic, and the closed forms of the
+	// chi-square probabilities for 4 degrees of freedom
+	ca := []int{10, 20, 30, 40, 5}
+	cb := []int{12, 18, 35, 35, 0}
+	a := newTestStatHisto1D(t, ca, false)
+	b := newTestStatHisto1D(t, cb, false)
+	wa := newTestStatHisto1D(t, ca, true)
+	wb := newTestStatHisto1D(t, cb, true)
+	for _, c := range []struct {
+		name string
+		a, b *Histo1D
+		mode Chi2Mode
+		want TestResult
+	}{
+		{"UU", a, b, Chi2UU, TestResult{Stat: 5.886580670791197, NDF: 4, PValue: 0.20778012492770645}},
+		// the empty bin of 'wb' takes the mean weight as its error
+		{"UW", a, wb, Chi2UW, TestResult{Stat: 8.611963079748431, NDF: 4, PValue: 0.07156517090295209}},
+		{"WW", wa, wb, Chi2WW, TestResult{Stat: 5.2120534955728095, NDF: 4, PValue: 0.2662232050175166}},
+	} {
+		r, err := Chi2Test(c.a, c.b, c.mode)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if r.NDF != c.want.NDF ||
+			math.Abs(r.Stat-c.want.Stat) > 1e-12*c.want.Stat ||
+			math.Abs(r.PValue-c.want.PValue) > 1e-9 {
+			t.Errorf("%s: got %+v, want %+v", c.name, r, c.want)
+		}
+	}
+
+	// identical histograms
+	r, err := Chi2Test(a, a, Chi2UU)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Stat != 0 || r.PValue != 1 {
+		t.Errorf("identical histograms: got %+v", r)
+	}
+}
+
+func TestKolmogorovTest(t *testing.T) {
+	// the reference values are computed in exact arithmetic, as in ROOT's
+	// TH1::KolmogorovTest, with the series of TMath::KolmogorovProb
+	for _, c := range []struct {
+		name     string
+		weighted bool
+		want     TestResult
+	}{
+		{"unweighted", false, TestResult{Stat: 0.07857142857142857, PValue: 0.9099144106782577}},
+		// the effective numbers of entries are used
+		{"weighted", true, TestResult{Stat: 0.07869961753456899, PValue: 0.9492175335389437}},
+	} {
+		a := newTestStatHisto1D(t, []int{10, 20, 30, 40, 5}, c.weighted)
+		b := newTestStatHisto1D(t, []int{12, 18, 35, 35, 0}, c.weighted)
+		r, err := KolmogorovTest(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.NDF != 0 || math.Abs(r.Stat-c.want.Stat) > 1e-12 || math.Abs(r.PValue-c.want.PValue) > 1e-9 {
+			t.Errorf("%s: got %+v, want %+v", c.name, r, c.want)
+		}
+	}
+
+	// tabulated values of the Kolmogorov distribution
+	for _, c := range []struct{ z, p float64 }{
+		{0.5, 0.9639452436648751},
+		{1, 0.26999967167735456},
+		{1.36, 0.049485876755377876},
+	} {
+		if p := kolmogorovProb(c.z); math.Abs(p-c.p) > 1e-9 {
+			t.Errorf("kolmogorovProb(%v) = %v, want %v", c.z, p, c.p)
+		}
+	}
+}
+
+func TestAndersonDarlingTest(t *testing.T) {
+	// the example of Scholz and Stephens: the smoothness of a paper, measured
+	// by four laboratories. The standardised statistic of A2akN is 4.480,
+	// and the p-value 0.0020.
+	samples := [][]float64{
+		{38.7, 41.5, 43.8, 44.5, 45.5, 46.0, 47.7, 58.0},
+		{39.2, 39.3, 39.7, 41.4, 41.8, 42.9, 43.3, 45.8},
+		{34.0, 35.0, 39.0, 40.0, 43.0, 43.0, 44.0, 45.0},
+		{34.0, 34.8, 34.8, 35.4, 37.2, 37.8, 41.2, 42.8},
+	}
+	// one bin per distinct value, holding the tied observations
+	var edges []float64
+	for _, s := range samples {
+		edges = append(edges, s...)
+	}
+	sort.Float64s(edges)
+	for i := 1; i < len(edges); {
+		if edges[i] == edges[i-1] {
+			edges = append(edges[:i], edges[i+1:]...)
+			continue
+		}
+		i++
+	}
+	edges = append(edges, edges[len(edges)-1]+1)
+	h, err := NewHisto1DFromEdges(edges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := make([][]float64, len(samples))
+	for i, s := range samples {
+		f[i] = make([]float64, h.NumBins())
+		for _, x := range s {
+			f[i][h.axis.BinIndex(x)]++
+		}
+	}
+	r, err := andersonDarling(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.NDF != 3 || math.Abs(r.Stat-4.480) > 5e-4 || math.Abs(r.PValue-0.0020) > 1e-4 {
+		t.Errorf("got %+v, want T=4.480, NDF=3 and p=0.0020", r)
+	}
+
+	// the 2-sample test of the first two laboratories
+	a, err := NewHisto1DFromEdges(edges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewHisto1DFromEdges(edges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for j := range f[0] {
+		a.FillBin(j, f[0][j])
+		b.FillBin(j, f[1][j])
+	}
+	r, err = AndersonDarlingTest(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r2, err := andersonDarling(f[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != r2 || r.NDF != 1 {
+		t.Errorf("2-sample test: got %+v, want %+v", r, r2)
+	}
+
+	// the critical values for k=2 samples
+	for _, c := range []struct{ t, p float64 }{
+		{0.325, 0.25},
+		{1.226, 0.1},
+		{1.961, 0.05},
+		{2.718, 0.025},
+		{3.752, 0.01},
+	} {
+		if p := adPValue(c.t, 1); math.Abs(p-c.p) > 0.1*c.p {
+			t.Errorf("adPValue(%v, 1) = %v, want %v", c.t, p, c.p)
+		}
+	}
+}
+
+func TestStatTestErrors(t *testing.T) {
+	a := newTestStatHisto1D(t, []int{10, 20, 30}, false)
+	empty := newTestStatHisto1D(t, []int{0, 0, 0}, false)
+	// a single non-empty bin: no degree of freedom
+	single := newTestStatHisto1D(t, []int{0, 5, 0}, false)
+	few := newTestStatHisto1D(t, []int{1, 1, 0}, false)
+	one := newTestStatHisto1D(t, []int{0, 0, 1}, false)
+	other, err := NewHisto1D(3, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other.Fill(1, 1)
+
+	type test func(a, b *Histo1D) (TestResult, error)
+	chi2 := func(mode Chi2Mode) test {
+		return func(a, b *Histo1D) (TestResult, error) { return Chi2Test(a, b, mode) }
+	}
+	for _, c := range []struct {
+		name string
+		f    test
+		a, b *Histo1D
+		err  error
+	}{
+		{"chi2 UU, empty", chi2(Chi2UU), a, empty, ErrLowStats},
+		{"chi2 UW, empty", chi2(Chi2UW), empty, a, ErrLowStats},
+		{"chi2 WW, single bin", chi2(Chi2WW), single, single, ErrLowStats},
+		{"chi2 UU, single bin", chi2(Chi2UU), single, single, ErrLowStats},
+		{"chi2 UU, binning", chi2(Chi2UU), a, other, ErrBinningMismatch},
+		{"chi2 WW, binning", chi2(Chi2WW), other, a, ErrBinningMismatch},
+		{"chi2, invalid mode", chi2(Chi2Mode(42)), a, a, ErrInvalidRange},
+		{"KS, empty", KolmogorovTest, empty, a, ErrLowStats},
+		{"KS, binning", KolmogorovTest, a, other, ErrBinningMismatch},
+		{"AD, empty", AndersonDarlingTest, a, empty, ErrLowStats},
+		{"AD, 3 observations", AndersonDarlingTest, few, one, ErrLowStats},
+		{"AD, binning", AndersonDarlingTest, a, other, ErrBinningMismatch},
+	} {
+		if _, err := c.f(c.a, c.b); !errors.Is(err, c.err) {
+			t.Errorf("%s: got error %v, want %v", c.name, err, c.err)
+		}
+	}
+}
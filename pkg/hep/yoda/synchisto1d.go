@@ -0,0 +1,74 @@
+package yoda
+
+import (
+	"sync"
+)
+
+// SyncHisto1D is a Histo1D which can be filled concurrently from multiple
+// goroutines.
+//
+// Fill and FillBin serialize the fills with a mutex. Under heavy contention,
+// each goroutine may instead fill its own Shard without any locking, and
+// Merge it back once done:
+//
+//  s := yoda.NewSyncHisto1D(h)
+//  for _, fname := range fnames {
+//      wg.Add(1)
+//      go func(fname string) {
+//          defer wg.Done()
+//          shard := s.Shard()
+//          // ... shard.Fill(x, w) ...
+//          s.Merge(shard)
+//      }(fname)
+//  }
+//  wg.Wait()
+type SyncHisto1D struct {
+	mu sync.Mutex
+	h  *Histo1D
+}
+
+// Create a new SyncHisto1D guarding the histogram 'h'.
+// 'h' must not be accessed directly while the SyncHisto1D is in use.
+func NewSyncHisto1D(h *Histo1D) *SyncHisto1D {
+	return &SyncHisto1D{h: h}
+}
+
+// Fill the histogram with weight 'weight' at position 'x'
+func (s *SyncHisto1D) Fill(x, weight float64) {
+	s.mu.Lock()
+	s.h.Fill(x, weight)
+	s.mu.Unlock()
+}
+
+// Fill the bin number 'id' with weight 'weight'
+func (s *SyncHisto1D) FillBin(id int, weight float64) {
+	s.mu.Lock()
+	s.h.FillBin(id, weight)
+	s.mu.Unlock()
+}
+
+// Returns a new, empty, histogram with the same binning as the guarded
+// one. The shard is owned by the caller and can be filled without locking.
+func (s *SyncHisto1D) Shard() *Histo1D {
+	s.mu.Lock()
+	shard := &Histo1D{axis: *s.h.axis.Clone()}
+	s.mu.Unlock()
+	shard.axis.Reset()
+	return shard
+}
+
+// Add the content of 'shard' to the guarded histogram.
+// The guarded histogram is left unchanged if the binning of 'shard'
+// differs.
+func (s *SyncHisto1D) Merge(shard *Histo1D) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return Axis1D_IAdd(&s.h.axis, &shard.axis)
+}
+
+// Returns a snapshot (a deep copy) of the guarded histogram
+func (s *SyncHisto1D) Histo() *Histo1D {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.h.Clone()
+}
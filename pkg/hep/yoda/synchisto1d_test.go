@@ -0,0 +1,137 @@
+package yoda
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSyncHisto1DConcurrent(t *testing.T) {
+	const (
+		nworkers = 8
+		nfills   = 1000
+	)
+	h, err := NewHisto1D(10, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewSyncHisto1D(h)
+	var wg sync.WaitGroup
+	for i := 0; i < nworkers; i++ {
+		wg.Add(2)
+		// filling through the mutex
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < nfills; j++ {
+				s.Fill(float64((i+j)%12)-1, 1)
+			}
+		}(i)
+		// filling a shard, merged back at the end
+		go func(i int) {
+			defer wg.Done()
+			shard := s.Shard()
+			for j := 0; j < nfills; j++ {
+				shard.Fill(float64((i+j)%12)-1, 1)
+				if j%100 == 0 {
+					s.Histo()
+				}
+			}
+			err := s.Merge(shard)
+			if err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	got := s.Histo()
+	if n, want := got.NumEntries(), uint64(2*nworkers*nfills); n != want {
+		t.Errorf("got %d entries, want %d", n, want)
+	}
+	sumw := 0.0
+	for _, b := range got.Bins() {
+		sumw += b.SumW()
+	}
+	sumw += got.Underflow().SumW() + got.Overflow().SumW()
+	if sumw != got.SumW() {
+		t.Errorf("sum of the bins %v != total %v", sumw, got.SumW())
+	}
+}
+
+func TestSyncHisto1DMergeMismatch(t *testing.T) {
+	h, err := NewHisto1D(4, 0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Fill(1.5, 1)
+	s := NewSyncHisto1D(h)
+	want := s.Histo()
+
+	shard, err := NewHisto1D(4, 0, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shard.Fill(1.5, 1)
+	err = s.Merge(shard)
+	if !errors.Is(err, ErrBinningMismatch) {
+		t.Fatalf("got error %v, want ErrBinningMismatch", err)
+	}
+	if got := s.Histo(); !reflect.DeepEqual(got, want) {
+		t.Errorf("histogram modified by a failed merge")
+	}
+}
+
+func BenchmarkSyncHisto1D(b *testing.B) {
+	for _, n := range []int{1, 2, 4, 8, 16, 32} {
+		b.Run(fmt.Sprintf("fill-%d", n), func(b *testing.B) {
+			h, err := NewHisto1D(100, 0, 1)
+			if err != nil {
+				b.Fatal(err)
+			}
+			s := NewSyncHisto1D(h)
+			benchSyncHisto1D(b, n, func(x float64) { s.Fill(x, 1) }, nil)
+		})
+		b.Run(fmt.Sprintf("shard-%d", n), func(b *testing.B) {
+			h, err := NewHisto1D(100, 0, 1)
+			if err != nil {
+				b.Fatal(err)
+			}
+			s := NewSyncHisto1D(h)
+			benchSyncHisto1D(b, n, nil, s)
+		})
+	}
+}
+
+// benchSyncHisto1D spreads b.N fills over 'n' goroutines, either through
+// 'fill' or through a shard of 's' per goroutine
+func benchSyncHisto1D(b *testing.B, n int, fill func(x float64), s *SyncHisto1D) {
+	var wg sync.WaitGroup
+	b.ResetTimer()
+	for i := 0; i < n; i++ {
+		nfills := b.N / n
+		if i < b.N%n {
+			nfills++
+		}
+		wg.Add(1)
+		go func(i, nfills int) {
+			defer wg.Done()
+			if s == nil {
+				for j := 0; j < nfills; j++ {
+					fill(float64((i+j)%1000) * 1e-3)
+				}
+				return
+			}
+			shard := s.Shard()
+			for j := 0; j < nfills; j++ {
+				shard.Fill(float64((i+j)%1000)*1e-3, 1)
+			}
+			err := s.Merge(shard)
+			if err != nil {
+				b.Error(err)
+			}
+		}(i, nfills)
+	}
+	wg.Wait()
+}
@@ -1,2 +1,29 @@
 package yoda
 
+import "errors"
+
+var (
+	// ErrLowStats is returned when a statistic is requested from a
+	// distribution without enough (effective) entries to compute it
+	ErrLowStats = errors.New("yoda: not enough statistics")
+
+	// ErrBinningMismatch is returned when combining objects whose binnings
+	// are not compatible
+	ErrBinningMismatch = errors.New("yoda: binning mismatch")
+
+	// ErrInvalidRange is returned when constructing an axis from an empty
+	// or inverted range, or from edges which are not strictly increasing
+	ErrInvalidRange = errors.New("yoda: invalid range")
+
+	// ErrInvalidEfficiency is returned when computing an efficiency from a
+	// 'pass' histogram with more weight than the 'total' one
+	ErrInvalidEfficiency = errors.New("yoda: invalid efficiency")
+
+	// ErrInvalidEncoding is returned when decoding a truncated or corrupted
+	// binary encoding, or one of an unsupported version
+	ErrInvalidEncoding = errors.New("yoda: invalid encoding")
+
+	// ErrIndexOutOfRange is returned when accessing a bin, outflow or point
+	// with an invalid index
+	ErrIndexOutOfRange = errors.New("yoda: index out of range")
+)
@@ -0,0 +1,164 @@
+package yoda
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// testFills calls 'fill' with the index and the weight of each of the 'n'
+// fills of the test fixtures
+func testFills(n int, fill func(i int, w float64)) {
+	for i := 0; i < n; i++ {
+		fill(i, 1+float64(i%3))
+	}
+}
+
+func newTestAxis1D(t *testing.T, edges ...float64) *Axis1D {
+	a, err := NewAxis1DFromEdges(edges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testFills(20, func(i int, w float64) {
+		x := edges[0] + float64(i)/19*(edges[len(edges)-1]-edges[0])
+		a.fill(x-0.5, w)
+	})
+	return a
+}
+
+func newTestAxis2D(t *testing.T, xedges, yedges []float64) *Axis2D {
+	a, err := NewAxis2DFromEdges(xedges, yedges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testFills(20, func(i int, w float64) {
+		a.fill(float64(i%5)-0.5, float64(i%4)-0.5, w)
+	})
+	return a
+}
+
+func newTestProfile1D(t *testing.T, edges ...float64) *Profile1D {
+	p, err := NewProfile1DFromEdges(edges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testFills(20, func(i int, w float64) {
+		p.Fill(float64(i%6)-0.5, float64(i), w)
+	})
+	return p
+}
+
+func newTestHisto1D(t *testing.T) *Histo1D {
+	h, err := NewHisto1D(10, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testFills(50, func(i int, w float64) {
+		h.Fill(float64(i%12)-1, w)
+	})
+	h.SetAnnotation("Title", "test")
+	return h
+}
+
+func TestLowStats(t *testing.T) {
+	// statistics of empty objects, and of objects filled once: the mean of
+	// a single entry is defined, not its spread
+	type stat struct {
+		name string
+		f    func() (float64, error)
+	}
+
+	bin := NewBin1D(0, 1)
+	h1, err := NewHisto1D(2, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h2, err := NewHisto2D(2, 0, 2, 2, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p1, err := NewProfile1D(2, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	means := []stat{
+		{"Bin1D.XMean", bin.XMean},
+		{"Histo1D.Mean", h1.Mean},
+		{"Histo2D.XMean", h2.XMean},
+		{"Histo2D.YMean", h2.YMean},
+		{"Profile1D.XMean", p1.XMean},
+		{"Profile1D.YMean", p1.YMean},
+		{"Profile1D bin YMean", p1.Bin(0).YMean},
+	}
+	spreads := []stat{
+		{"Bin1D.XVariance", bin.XVariance},
+		{"Bin1D.XStdDev", bin.XStdDev},
+		{"Bin1D.XStdError", bin.XStdError},
+		{"Histo1D.Variance", h1.Variance},
+		{"Histo1D.StdDev", h1.StdDev},
+		{"Histo1D.StdErr", h1.StdErr},
+		{"Histo2D.XVariance", h2.XVariance},
+		{"Histo2D.YVariance", h2.YVariance},
+		{"Histo2D.XStdDev", h2.XStdDev},
+		{"Histo2D.YStdErr", h2.YStdErr},
+		{"Profile1D bin YVariance", p1.Bin(0).YVariance},
+		{"Profile1D bin YStdDev", p1.Bin(0).YStdDev},
+		{"Profile1D bin YStdErr", p1.Bin(0).YStdErr},
+	}
+
+	for _, s := range append(means, spreads...) {
+		if _, err := s.f(); !errors.Is(err, ErrLowStats) {
+			t.Errorf("empty %s: got error %v, want %v", s.name, err, ErrLowStats)
+		}
+	}
+
+	bin.xdbn.fill(0.5, 2)
+	h1.Fill(0.5, 2)
+	h2.Fill(0.5, 1.5, 2)
+	p1.Fill(0.5, 3, 2)
+	for _, s := range means {
+		if _, err := s.f(); err != nil {
+			t.Errorf("single entry %s: %v", s.name, err)
+		}
+	}
+	for _, s := range spreads {
+		if _, err := s.f(); !errors.Is(err, ErrLowStats) {
+			t.Errorf("single entry %s: got error %v, want %v", s.name, err, ErrLowStats)
+		}
+	}
+}
+
+func TestInvalidRange(t *testing.T) {
+	for _, c := range []struct {
+		lo, hi float64
+		n      int
+	}{
+		{0, 1, 0},
+		{0, 1, -1},
+		{1, 1, 10},
+		{1, 0, 10},
+		{0, math.Inf(+1), 10},
+		{math.NaN(), 1, 10},
+		{1, 1 + 1e-15, 1000},
+	} {
+		if _, err := linspace(c.lo, c.hi, c.n); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("linspace(%v, %v, %d): got error %v, want %v", c.lo, c.hi, c.n, err, ErrInvalidRange)
+		}
+		if _, err := NewHisto1D(c.n, c.lo, c.hi); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("NewHisto1D(%d, %v, %v): got error %v, want %v", c.n, c.lo, c.hi, err, ErrInvalidRange)
+		}
+	}
+
+	for _, edges := range [][]float64{
+		nil,
+		{0},
+		{0, 0},
+		{0, 1, 1, 2},
+		{0, 2, 1},
+		{0, math.NaN(), 1},
+	} {
+		if _, err := NewAxis1DFromEdges(edges); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("NewAxis1DFromEdges(%v): got error %v, want %v", edges, err, ErrInvalidRange)
+		}
+	}
+}
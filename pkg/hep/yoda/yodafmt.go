@@ -0,0 +1,362 @@
+package yoda
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WriteYODA writes the analysis objects to 'w' in the YODA text format.
+// Histo1D objects are written as YODA_HISTO1D blocks and Scatter2D objects
+// as YODA_SCATTER2D blocks. Floating point values are written with enough
+// digits to be read back exactly.
+func WriteYODA(w io.Writer, objs ...Object) error {
+	bw := bufio.NewWriter(w)
+	for _, o := range objs {
+		var err error
+		switch o := o.(type) {
+		case *Histo1D:
+			err = writeYODAHisto1D(bw, o)
+		case *Scatter2D:
+			err = writeYODAScatter2D(bw, o)
+		default:
+			err = fmt.Errorf("yoda: cannot write a %s in YODA format", o.Type())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// ReadYODA reads all the analysis objects stored in the YODA text format
+// from 'r'. YODA_HISTO1D blocks are read as Histo1D objects and
+// YODA_SCATTER2D blocks as Scatter2D objects.
+// Only the format written by YODA 1 is read: annotations are 'key=value'
+// lines. The YAML 'Key: value' annotations, the '---' separators and the
+// _V2 block types of the format written by YODA 2 are rejected.
+func ReadYODA(r io.Reader) ([]Object, error) {
+	var objs []Object
+	var blk *yodaBlock
+	scan := bufio.NewScanner(r)
+	scan.Buffer(nil, 1<<24)
+	iline := 0
+	for scan.Scan() {
+		iline++
+		line := strings.TrimSpace(scan.Text())
+		if line == "" {
+			continue
+		}
+		if blk == nil {
+			if !strings.HasPrefix(line, "# BEGIN YODA_") {
+				if strings.HasPrefix(line, "#") {
+					continue
+				}
+				return nil, fmt.Errorf("yoda: line %d: data outside of a BEGIN/END block", iline)
+			}
+			fields := strings.Fields(line[len("# BEGIN YODA_"):])
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("yoda: line %d: BEGIN line without a type: %w", iline, ErrInvalidEncoding)
+			}
+			blk = &yodaBlock{typ: fields[0], ann: make(Annotations)}
+			if len(fields) > 1 {
+				blk.path = fields[1]
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "# END YODA_") {
+			o, err := blk.object()
+			if err != nil {
+				return nil, fmt.Errorf("yoda: line %d: %w", iline, err)
+			}
+			objs = append(objs, o)
+			blk = nil
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if i := strings.Index(line, "="); i > 0 && !strings.ContainsAny(line[:i], " \t") {
+			blk.ann[line[:i]] = line[i+1:]
+			continue
+		}
+		err := blk.addRow(strings.Fields(line))
+		if err != nil {
+			return nil, fmt.Errorf("yoda: line %d: %w", iline, err)
+		}
+	}
+	if err := scan.Err(); err != nil {
+		return nil, err
+	}
+	if blk != nil {
+		return nil, fmt.Errorf("yoda: missing END for YODA_%s block", blk.typ)
+	}
+	return objs, nil
+}
+
+// yfmt formats a float64 with the minimal number of digits needed to
+// represent it exactly
+func yfmt(v float64) string {
+	return strconv.FormatFloat(v, 'e', -1, 64)
+}
+
+// writeYODAHeader writes the BEGIN line and the annotations of an object.
+// An annotation is written as a single 'key=value' line: keys containing
+// '=' or white space and values spanning several lines are rejected, as
+// they could not be read back.
+func writeYODAHeader(w *bufio.Writer, o Object, block string) error {
+	ann := o.Annotations()
+	keys := make([]string, 0, len(ann))
+	vals := make(map[string]string, len(ann))
+	for k, v := range ann {
+		if k == "Type" {
+			continue
+		}
+		if k == "" || strings.ContainsAny(k, "= \t\r\n") {
+			return fmt.Errorf("yoda: %s: annotation key %q: %w", o.Path(), k, ErrInvalidEncoding)
+		}
+		vals[k] = fmt.Sprintf("%v", v)
+		if strings.ContainsAny(vals[k], "\r\n") {
+			return fmt.Errorf("yoda: %s: multi-line value of annotation %q: %w", o.Path(), k, ErrInvalidEncoding)
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	fmt.Fprintf(w, "# BEGIN %s %s\n", block, o.Path())
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s=%s\n", k, vals[k])
+	}
+	_, err := fmt.Fprintf(w, "Type=%s\n", o.Type())
+	return err
+}
+
+func writeYODADbn1D(w *bufio.Writer, lbl string, d *dbn1d) {
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+		lbl, lbl, yfmt(d.sumw), yfmt(d.sumw2), yfmt(d.sumwx), yfmt(d.sumwx2), d.nfills)
+}
+
+func writeYODAHisto1D(w *bufio.Writer, h *Histo1D) error {
+	err := writeYODAHeader(w, h, "YODA_HISTO1D")
+	if err != nil {
+		return err
+	}
+	if mean, err := h.axis.dbn.mean(); err == nil {
+		fmt.Fprintf(w, "# Mean: %s\n", yfmt(mean))
+	}
+	fmt.Fprintf(w, "# Area: %s\n", yfmt(h.Integral(true)))
+	fmt.Fprintf(w, "# ID\t ID\t sumw\t sumw2\t sumwx\t sumwx2\t numEntries\n")
+	writeYODADbn1D(w, "Total", &h.axis.dbn)
+	writeYODADbn1D(w, "Underflow", &h.axis.underflow)
+	writeYODADbn1D(w, "Overflow", &h.axis.overflow)
+	fmt.Fprintf(w, "# xlow\t xhigh\t sumw\t sumw2\t sumwx\t sumwx2\t numEntries\n")
+	for i := range h.axis.bins {
+		b := &h.axis.bins[i]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+			yfmt(b.XMin()), yfmt(b.XMax()),
+			yfmt(b.xdbn.sumw), yfmt(b.xdbn.sumw2),
+			yfmt(b.xdbn.sumwx), yfmt(b.xdbn.sumwx2),
+			b.xdbn.nfills)
+	}
+	_, err = fmt.Fprintf(w, "# END YODA_HISTO1D\n\n")
+	return err
+}
+
+func writeYODAScatter2D(w *bufio.Writer, s *Scatter2D) error {
+	err := writeYODAHeader(w, s, "YODA_SCATTER2D")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "# xval\t xerr-\t xerr+\t yval\t yerr-\t yerr+\n")
+	for _, p := range s.points {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			yfmt(p.X()), yfmt(p.XErrMinus()), yfmt(p.XErrPlus()),
+			yfmt(p.Y()), yfmt(p.YErrMinus()), yfmt(p.YErrPlus()))
+	}
+	_, err = fmt.Fprintf(w, "# END YODA_SCATTER2D\n\n")
+	return err
+}
+
+// yodaBlock accumulates the content of a BEGIN/END block
+type yodaBlock struct {
+	typ  string
+	path string
+	ann  Annotations
+	rows [][]float64 // points of a SCATTER2D block
+	bins []yodaBin   // bins of a HISTO1D block
+
+	// labelled distributions (Total, Underflow, Overflow)
+	dbns map[string]*dbn1d
+}
+
+// yodaBin is a bin row of a HISTO1D block
+type yodaBin struct {
+	xlow, xhigh float64
+	dbn         dbn1d
+}
+
+func (b *yodaBlock) addRow(fields []string) error {
+	switch fields[0] {
+	case "Total", "Underflow", "Overflow":
+		if len(fields) != 7 {
+			return fmt.Errorf("invalid %s row (%d fields)", fields[0], len(fields))
+		}
+		d, err := parseDbn1D(fields[2:])
+		if err != nil {
+			return err
+		}
+		if b.dbns == nil {
+			b.dbns = make(map[string]*dbn1d)
+		}
+		b.dbns[fields[0]] = &d
+		return nil
+	}
+	if b.typ == "HISTO1D" {
+		if len(fields) != 7 {
+			return fmt.Errorf("invalid histo1d bin row (%d fields)", len(fields))
+		}
+		edges, err := parseFloats(fields[:2])
+		if err != nil {
+			return err
+		}
+		d, err := parseDbn1D(fields[2:])
+		if err != nil {
+			return err
+		}
+		b.bins = append(b.bins, yodaBin{xlow: edges[0], xhigh: edges[1], dbn: d})
+		return nil
+	}
+	vs, err := parseFloats(fields)
+	if err != nil {
+		return err
+	}
+	b.rows = append(b.rows, vs)
+	return nil
+}
+
+// parseDbn1D parses the sumw, sumw2, sumwx, sumwx2 and numEntries columns
+// of a distribution. numEntries is parsed as an integer, so that it is read
+// back exactly.
+func parseDbn1D(fields []string) (dbn1d, error) {
+	vs, err := parseFloats(fields[:4])
+	if err != nil {
+		return dbn1d{}, err
+	}
+	n, err := strconv.ParseUint(fields[4], 10, 64)
+	if err != nil {
+		return dbn1d{}, fmt.Errorf("invalid numEntries %q: %w", fields[4], ErrInvalidEncoding)
+	}
+	return dbn1d{
+		sumw:   vs[0],
+		sumw2:  vs[1],
+		sumwx:  vs[2],
+		sumwx2: vs[3],
+		nfills: n,
+	}, nil
+}
+
+func parseFloats(fields []string) ([]float64, error) {
+	vs := make([]float64, len(fields))
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return nil, err
+		}
+		vs[i] = v
+	}
+	return vs, nil
+}
+
+// object builds the analysis object described by the block
+func (b *yodaBlock) object() (Object, error) {
+	var o Object
+	var err error
+	switch b.typ {
+	case "HISTO1D":
+		o, err = b.histo1d()
+	case "SCATTER2D":
+		o, err = b.scatter2d()
+	default:
+		err = fmt.Errorf("unsupported block type YODA_%s", b.typ)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := b.ann["Path"]; !ok && b.path != "" {
+		b.ann["Path"] = b.path
+	}
+	delete(b.ann, "Type")
+	for k, v := range b.ann {
+		o.SetAnnotation(k, v)
+	}
+	return o, nil
+}
+
+func (b *yodaBlock) histo1d() (*Histo1D, error) {
+	if len(b.bins) == 0 {
+		return nil, errors.New("histo1d without bins")
+	}
+	sort.Sort(sorted_bins(b.bins))
+	edges := make([]float64, 0, len(b.bins)+1)
+	for i, bin := range b.bins {
+		if i > 0 && bin.xlow != b.bins[i-1].xhigh {
+			return nil, errors.New("histo1d with gaps between bins is not supported")
+		}
+		edges = append(edges, bin.xlow)
+	}
+	edges = append(edges, b.bins[len(b.bins)-1].xhigh)
+
+	h, err := NewHisto1DFromEdges(edges)
+	if err != nil {
+		return nil, err
+	}
+	for i := range b.bins {
+		h.axis.bins[i].xdbn = b.bins[i].dbn
+	}
+	if d, ok := b.dbns["Underflow"]; ok {
+		h.axis.underflow = *d
+	}
+	if d, ok := b.dbns["Overflow"]; ok {
+		h.axis.overflow = *d
+	}
+	if d, ok := b.dbns["Total"]; ok {
+		h.axis.dbn = *d
+	} else {
+		dbn1d_iadd(&h.axis.dbn, &h.axis.underflow)
+		dbn1d_iadd(&h.axis.dbn, &h.axis.overflow)
+		for i := range h.axis.bins {
+			dbn1d_iadd(&h.axis.dbn, &h.axis.bins[i].xdbn)
+		}
+	}
+	return h, nil
+}
+
+func (b *yodaBlock) scatter2d() (*Scatter2D, error) {
+	s := &Scatter2D{points: make([]*Point2D, 0, len(b.rows))}
+	for _, row := range b.rows {
+		if len(row) != 6 {
+			return nil, fmt.Errorf("invalid scatter2d point row (%d fields)", len(row))
+		}
+		s.points = append(s.points,
+			NewPoint2DAsymErr(row[0], row[3], row[1], row[2], row[4], row[5]))
+	}
+	return s, nil
+}
+
+// a list of bin rows sorted by lower edge
+type sorted_bins []yodaBin
+
+func (s sorted_bins) Len() int {
+	return len(s)
+}
+
+func (s sorted_bins) Less(i, j int) bool {
+	return s[i].xlow < s[j].xlow
+}
+
+func (s sorted_bins) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
@@ -0,0 +1,153 @@
+package yoda
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadYODAInvalidBegin(t *testing.T) {
+	for _, src := range []string{
+		"# BEGIN YODA_\n# END YODA_\n",
+		"# BEGIN YODA_   \n",
+	} {
+		_, err := ReadYODA(strings.NewReader(src))
+		if !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("ReadYODA(%q): got error %v, want ErrInvalidEncoding", src, err)
+		}
+	}
+}
+
+func TestWriteYODAAnnotations(t *testing.T) {
+	h, err := NewHisto1D(2, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.SetPath("/h")
+	h.SetTitle(`$p_\perp$ of the \nu`)
+	h.Fill(0.25, 1)
+	var buf bytes.Buffer
+	err = WriteYODA(&buf, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs, err := ReadYODA(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 1 || objs[0].Title() != h.Title() {
+		t.Fatalf("round-trip of the title: got %v", objs)
+	}
+
+	for _, c := range []struct {
+		key string
+		val interface{}
+	}{
+		{"Title", "first line\nsecond line"},
+		{"Title", "first line\r"},
+		{"Some key", "value"},
+		{"a=b", "value"},
+		{"", "value"},
+	} {
+		h.SetAnnotation(c.key, c.val)
+		buf.Reset()
+		err := WriteYODA(&buf, h)
+		if !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("annotation %q=%q: got error %v, want ErrInvalidEncoding", c.key, c.val, err)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("annotation %q=%q: partial block written: %q", c.key, c.val, buf.String())
+		}
+		h.RemoveAnnotation(c.key)
+	}
+}
+
+func TestYODAHisto1DRoundTrip(t *testing.T) {
+	h, err := NewHisto1DFromEdges([]float64{-1, 0.1, 1.0 / 3, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.SetPath("/h")
+	for i := 0; i < 50; i++ {
+		x := -1.5 + 0.07*float64(i)
+		h.Fill(x, 1/(1+0.3*float64(i)))
+	}
+	h.Fill(1e-300, math.Pi)
+	h.Fill(2.5, 0.7)
+	// more entries than a float64 counts exactly
+	h.axis.bins[1].xdbn.nfills = 1<<60 + 1
+	h.axis.underflow.nfills = 1<<53 + 1
+	h.axis.overflow.nfills = math.MaxUint64
+	if h.Underflow().NumEntries() == 0 || h.Overflow().SumW() == 0 {
+		t.Fatalf("no under|over-flows to test")
+	}
+
+	var buf bytes.Buffer
+	err = WriteYODA(&buf, h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs, err := ReadYODA(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 1 {
+		t.Fatalf("got %d objects, want 1", len(objs))
+	}
+	o := objs[0].(*Histo1D)
+	if !reflect.DeepEqual(o.axis.edges, h.axis.edges) {
+		t.Errorf("edges: got %v, want %v", o.axis.edges, h.axis.edges)
+	}
+	for i := range h.axis.bins {
+		if got, want := o.axis.bins[i].xdbn, h.axis.bins[i].xdbn; got != want {
+			t.Errorf("bin %d:\ngot:  %+v\nwant: %+v", i, got, want)
+		}
+	}
+	for _, v := range []struct {
+		name      string
+		got, want dbn1d
+	}{
+		{"underflow", o.axis.underflow, h.axis.underflow},
+		{"overflow", o.axis.overflow, h.axis.overflow},
+		{"total", o.axis.dbn, h.axis.dbn},
+	} {
+		if v.got != v.want {
+			t.Errorf("%s:\ngot:  %+v\nwant: %+v", v.name, v.got, v.want)
+		}
+	}
+}
+
+func TestReadYODAInvalid(t *testing.T) {
+	const hdr = "# BEGIN YODA_HISTO1D /h\n"
+	const end = "# END YODA_HISTO1D\n"
+	for _, c := range []struct {
+		name string
+		src  string
+	}{
+		{"negative numEntries", hdr + "0\t1\t1\t1\t0.5\t0.25\t-1\n" + end},
+		{"fractional numEntries", hdr + "0\t1\t1\t1\t0.5\t0.25\t1.5\n" + end},
+		{"NaN numEntries", hdr + "0\t1\t1\t1\t0.5\t0.25\tNaN\n" + end},
+		{"float numEntries", hdr + "Total\tTotal\t1\t1\t0.5\t0.25\t1e3\n0\t1\t1\t1\t0.5\t0.25\t1\n" + end},
+	} {
+		_, err := ReadYODA(strings.NewReader(c.src))
+		if !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("%s: got error %v, want ErrInvalidEncoding", c.name, err)
+		}
+	}
+
+	// the YODA 2 format is not supported
+	for _, src := range []string{
+		"BEGIN YODA_HISTO1D_V2 /h\nPath: /h\n---\n",
+		"# BEGIN YODA_HISTO1D_V2 /h\n# END YODA_HISTO1D_V2\n",
+		hdr + "Path: /h\n" + end,
+		hdr + "---\n" + end,
+	} {
+		_, err := ReadYODA(strings.NewReader(src))
+		if err == nil {
+			t.Errorf("ReadYODA(%q): no error", src)
+		}
+	}
+}